@@ -2,9 +2,11 @@ package collection
 
 import (
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
 )
 
 type IndexerKeeper interface {
 	IsSealed() bool
 	GetSchemaBuilder() *collections.SchemaBuilder
+	GetAddressCodec() address.Codec
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"net"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cast"
@@ -15,6 +16,13 @@ const (
 	flagIndexerCacheCapacity = "indexer.cache-capacity"
 	flagIndexerRetainHeight  = "indexer.retain-height"
 	flagIndexerBackend       = "indexer.backend"
+
+	flagIndexerReconcileInterval = "indexer.reconcile-interval"
+	flagIndexerMaxLag            = "indexer.max-lag"
+	flagIndexerAdminEnable       = "indexer.admin-enable"
+	flagIndexerAdminAddress      = "indexer.admin-address"
+
+	defaultMaxLag = 5
 )
 
 func NewConfig(appOpts servertypes.AppOptions) (*IndexerConfig, error) {
@@ -29,6 +37,17 @@ func NewConfig(appOpts servertypes.AppOptions) (*IndexerConfig, error) {
 
 	cfg.RetainHeight = cast.ToInt64(appOpts.Get(flagIndexerRetainHeight))
 
+	cfg.ReconcileInterval = cast.ToInt64(appOpts.Get(flagIndexerReconcileInterval))
+
+	// max-lag is missing in the app.toml generated before it was introduced
+	cfg.MaxLag = defaultMaxLag
+	if maxLag := appOpts.Get(flagIndexerMaxLag); maxLag != nil {
+		cfg.MaxLag = cast.ToInt64(maxLag)
+	}
+
+	cfg.AdminEnable = cast.ToBool(appOpts.Get(flagIndexerAdminEnable))
+	cfg.AdminAddress = cast.ToString(appOpts.Get(flagIndexerAdminAddress))
+
 	cfg.BackendConfig = viper.New()
 	err := cfg.BackendConfig.MergeConfigMap(cast.ToStringMap(appOpts.Get(flagIndexerBackend)))
 	if err != nil {
@@ -51,6 +70,20 @@ func (c IndexerConfig) Validate() error {
 		return fmt.Errorf("retain height must be nonnegative")
 	}
 
+	if c.ReconcileInterval < 0 {
+		return fmt.Errorf("reconcile interval must be nonnegative")
+	}
+
+	if c.MaxLag < 0 {
+		return fmt.Errorf("max lag must be nonnegative")
+	}
+
+	if c.AdminEnable {
+		if err := validateLocalAddress(c.AdminAddress); err != nil {
+			return fmt.Errorf("invalid admin address: %w", err)
+		}
+	}
+
 	if c.BackendConfig == nil {
 		return fmt.Errorf("backend config must be set")
 	}
@@ -62,11 +95,33 @@ func (c IndexerConfig) IsEnabled() bool {
 	return c.Enable
 }
 
+// validateLocalAddress checks that the address is a host:port bound to the loopback interface.
+func validateLocalAddress(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+
+	return fmt.Errorf("%s is not a localhost address", addr)
+}
+
 func DefaultConfig() IndexerConfig {
 	return IndexerConfig{
 		Enable:        true,
 		CacheCapacity: 500, // 500 MiB
 		RetainHeight:  0,
 		BackendConfig: store.DefaultConfig(),
+
+		ReconcileInterval: 0,
+		MaxLag:            defaultMaxLag,
+		AdminEnable:       false,
+		AdminAddress:      "127.0.0.1:9092",
 	}
 }
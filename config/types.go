@@ -13,6 +13,16 @@ type IndexerConfig struct {
 	// RetainHeight is the height to retain indexer data.
 	// If 0, it will retain all data.
 	RetainHeight int64 `mapstructure:"indexer.retain-height"`
+	// ReconcileInterval is the block interval to reconcile the indexed state with the VM store.
+	// The reconciliation runs in background on the committed state, so the reindex source must be set.
+	// If 0, the reconciliation runs only on demand.
+	ReconcileInterval int64 `mapstructure:"indexer.reconcile-interval"`
+	// MaxLag is the number of blocks the indexer can be behind the chain while being reported as ready.
+	MaxLag int64 `mapstructure:"indexer.max-lag"`
+	// AdminEnable defines whether the admin gRPC service is enabled.
+	AdminEnable bool `mapstructure:"indexer.admin-enable"`
+	// AdminAddress is the address the admin gRPC service listens on. It must be a localhost address.
+	AdminAddress string `mapstructure:"indexer.admin-address"`
 	// Backend defines the type of the backend store and its options.
 	//  It should have a key-value pair named 'type', and the value should exist in store supported by cosmos-db.
 	// Recommend to use default value unless you know about backend db storage.
@@ -37,6 +47,21 @@ cache-capacity = {{ .IndexerConfig.CacheCapacity }}
 # If 0, it will retain all data.
 retain-height = {{ .IndexerConfig.RetainHeight }}
 
+# ReconcileInterval is the block interval to reconcile the indexed state with the VM store.
+# The reconciliation walks all the indexed collections and tokens in background, so keep it large enough.
+# If 0, the reconciliation runs only on demand.
+reconcile-interval = {{ .IndexerConfig.ReconcileInterval }}
+
+# MaxLag is the number of blocks the indexer can be behind the chain while being reported as ready.
+max-lag = {{ .IndexerConfig.MaxLag }}
+
+# AdminEnable defines whether the admin gRPC service is enabled.
+# It allows to prune, pause, resume, flush and reindex the indexer at runtime.
+admin-enable = {{ .IndexerConfig.AdminEnable }}
+
+# AdminAddress is the address the admin gRPC service listens on. It must be a localhost address.
+admin-address = "{{ .IndexerConfig.AdminAddress }}"
+
 # Backend defines the type of the backend store and its options.
 # It should have a key-value pair named 'type', and the value should exist in store supported by cosmos-db.
 # Recommend to use default value unless you know about backend db storage.
@@ -52,7 +52,10 @@ func (i Indexer) Start(ctxMap map[string]context.Context) error {
 	if !i.keeper.IsSealed() {
 		return errors.New("indexer cannot start because the keeper is not sealed")
 	}
-	return i.keeper.Start(ctxMap)
+	if err := i.keeper.Start(ctxMap); err != nil {
+		return err
+	}
+	return i.keeper.StartAdminServer(i.logger)
 }
 
 func (i Indexer) Validate() error {
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenKey is the key of the tokens: key(collection address, token id)
+type TokenKey = collections.Pair[sdk.AccAddress, string]
+
+// TokenMap is the token store of the nft submodules. It's implemented by both collections.Map and collections.IndexedMap.
+type TokenMap interface {
+	Get(ctx context.Context, key TokenKey) (IndexedToken, error)
+	Has(ctx context.Context, key TokenKey) (bool, error)
+	Set(ctx context.Context, key TokenKey, value IndexedToken) error
+	Walk(ctx context.Context, ranger collections.Ranger[TokenKey], walkFunc func(key TokenKey, value IndexedToken) (stop bool, err error)) error
+}
+
+// Indices are the indices shared by the nft submodules, used to verify and reconcile them.
+type Indices struct {
+	// Collections: key(collection address), value(collection)
+	Collections *collections.Map[sdk.AccAddress, IndexedCollection]
+	// CollectionOwners: key(owner address, collection address), value(owner's collection count)
+	CollectionOwners *collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], uint64]
+	// Tokens: key(collection address, token id), value(token)
+	Tokens TokenMap
+	// TokenOwners: key(owner address, collection address, token id), value(bool as placeholder)
+	TokenOwners *collections.Map[collections.Triple[sdk.AccAddress, sdk.AccAddress, string], bool]
+}
@@ -0,0 +1,308 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	kvindexer "github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+// TokenReader reads the token of the key and its owner from the VM store.
+type TokenReader func(ctx context.Context, key TokenKey, token IndexedToken) (*Token, sdk.AccAddress, error)
+
+// CollectionReader reads the collection of the address from the VM store.
+type CollectionReader func(ctx context.Context, collectionAddr sdk.AccAddress) (*Collection, error)
+
+// Reconcile re-reads the indexed tokens and collections with the readers and fixes divergent owners, metadata
+// and counters in the indices. The entries which can't be read are left untouched and returned as failures.
+// If the VM doesn't track nfts.length of a collection, the number of its indexed tokens is used instead.
+func (idx Indices) Reconcile(ctx context.Context, ac address.Codec, readToken TokenReader, readCollection CollectionReader) ([]kvindexer.Correction, []kvindexer.ReconcileFailure, error) {
+	corrections := []kvindexer.Correction{}
+	failures := []kvindexer.ReconcileFailure{}
+	addrString := func(addr sdk.AccAddress) string {
+		s, err := ac.BytesToString(addr)
+		if err != nil {
+			return fmt.Sprintf("%X", []byte(addr))
+		}
+		return s
+	}
+
+	// load everything first, the maps are updated while reconciling
+	tokenKeys := []TokenKey{}
+	tokens := []IndexedToken{}
+	err := idx.Tokens.Walk(ctx, nil, func(key TokenKey, token IndexedToken) (bool, error) {
+		tokenKeys = append(tokenKeys, key)
+		tokens = append(tokens, token)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// tokens: metadata and owner
+	tokenCounts := make(map[string]int64)
+	for i, key := range tokenKeys {
+		token := tokens[i]
+		target := addrString(key.K1()) + "/" + key.K2()
+		tokenCounts[string(key.K1())]++
+
+		nft, owner, err := readToken(ctx, key, token)
+		if err != nil {
+			failures = append(failures, kvindexer.ReconcileFailure{Target: target, Error: err.Error()})
+			continue
+		}
+
+		// the TokenOwners entries of the owner are fixed by reconcileTokenOwners
+		fixed := token.Reconcile(target, nft)
+		if ownerAddr := addrString(owner); token.OwnerAddr != ownerAddr {
+			fixed = append(fixed, kvindexer.Correction{
+				Target:   target,
+				Field:    "owner_addr",
+				OldValue: token.OwnerAddr,
+				NewValue: ownerAddr,
+			})
+			token.OwnerAddr = ownerAddr
+		}
+
+		if len(fixed) == 0 {
+			continue
+		}
+		if err = idx.Tokens.Set(ctx, key, token); err != nil {
+			return nil, nil, err
+		}
+		tokens[i] = token
+		corrections = append(corrections, fixed...)
+	}
+
+	// token owners: drop the entries not matching the tokens and add the missing ones
+	fixed, err := idx.reconcileTokenOwners(ctx, ac, addrString, tokenKeys, tokens)
+	if err != nil {
+		return nil, nil, err
+	}
+	corrections = append(corrections, fixed...)
+
+	// collection owners: recount from the tokens
+	fixed, err = idx.reconcileCollectionOwners(ctx, ac, addrString, tokenKeys, tokens)
+	if err != nil {
+		return nil, nil, err
+	}
+	corrections = append(corrections, fixed...)
+
+	// collections: metadata and nfts.length
+	collectionAddrs := []sdk.AccAddress{}
+	collectionList := []IndexedCollection{}
+	err = idx.Collections.Walk(ctx, nil, func(key sdk.AccAddress, collection IndexedCollection) (bool, error) {
+		collectionAddrs = append(collectionAddrs, key)
+		collectionList = append(collectionList, collection)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, addr := range collectionAddrs {
+		collection := collectionList[i]
+		target := addrString(addr)
+
+		authoritative, err := readCollection(ctx, addr)
+		if err != nil {
+			failures = append(failures, kvindexer.ReconcileFailure{Target: target, Error: err.Error()})
+			continue
+		}
+		if authoritative.Nfts == nil {
+			authoritative.Nfts = &TokenHandle{}
+		}
+		if authoritative.Nfts.Length == "" {
+			authoritative.Nfts.Length = strconv.FormatInt(tokenCounts[string(addr)], 10)
+		}
+
+		fixed := collection.Reconcile(target, authoritative)
+		if len(fixed) == 0 {
+			continue
+		}
+		if err = idx.Collections.Set(ctx, addr, collection); err != nil {
+			return nil, nil, err
+		}
+		corrections = append(corrections, fixed...)
+	}
+
+	return corrections, failures, nil
+}
+
+// reconcileTokenOwners removes the TokenOwners entries which don't match the owner of the token,
+// and adds the entries missing for the owners of the tokens.
+func (idx Indices) reconcileTokenOwners(ctx context.Context, ac address.Codec, addrString func(sdk.AccAddress) string, tokenKeys []TokenKey, tokens []IndexedToken) ([]kvindexer.Correction, error) {
+	owners := make(map[[2]string]string, len(tokenKeys))
+	for i, key := range tokenKeys {
+		owners[[2]string{string(key.K1()), key.K2()}] = tokens[i].OwnerAddr
+	}
+
+	indexed := make(map[[2]string]bool, len(tokenKeys))
+	stale := []collections.Triple[sdk.AccAddress, sdk.AccAddress, string]{}
+	err := idx.TokenOwners.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, string], _ bool) (bool, error) {
+		tokenKey := [2]string{string(key.K2()), key.K3()}
+		if owner, found := owners[tokenKey]; !found || owner != addrString(key.K1()) {
+			stale = append(stale, key)
+			return false, nil
+		}
+		indexed[tokenKey] = true
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	corrections := []kvindexer.Correction{}
+	for _, key := range stale {
+		if err = idx.TokenOwners.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+		corrections = append(corrections, kvindexer.Correction{
+			Target:   addrString(key.K2()) + "/" + key.K3(),
+			Field:    "token_owner",
+			OldValue: addrString(key.K1()),
+		})
+	}
+
+	for i, key := range tokenKeys {
+		if indexed[[2]string{string(key.K1()), key.K2()}] {
+			continue
+		}
+		owner, err := ac.StringToBytes(tokens[i].OwnerAddr)
+		if err != nil {
+			continue
+		}
+		if err = idx.TokenOwners.Set(ctx, collections.Join3(sdk.AccAddress(owner), key.K1(), key.K2()), true); err != nil {
+			return nil, err
+		}
+		corrections = append(corrections, kvindexer.Correction{
+			Target:   addrString(key.K1()) + "/" + key.K2(),
+			Field:    "token_owner",
+			NewValue: tokens[i].OwnerAddr,
+		})
+	}
+
+	return corrections, nil
+}
+
+// reconcileCollectionOwners sets the CollectionOwners counts to the number of tokens owned.
+func (idx Indices) reconcileCollectionOwners(ctx context.Context, ac address.Codec, addrString func(sdk.AccAddress) string, tokenKeys []TokenKey, tokens []IndexedToken) ([]kvindexer.Correction, error) {
+	// key(owner address, collection address)
+	counts := make(map[[2]string]uint64)
+	keys := []collections.Pair[sdk.AccAddress, sdk.AccAddress]{}
+	for i, key := range tokenKeys {
+		owner, err := ac.StringToBytes(tokens[i].OwnerAddr)
+		if err != nil {
+			continue
+		}
+		countKey := [2]string{string(owner), string(key.K1())}
+		if counts[countKey] == 0 {
+			keys = append(keys, collections.Join(sdk.AccAddress(owner), key.K1()))
+		}
+		counts[countKey]++
+	}
+
+	indexed := make(map[[2]string]uint64)
+	err := idx.CollectionOwners.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], count uint64) (bool, error) {
+		countKey := [2]string{string(key.K1()), string(key.K2())}
+		indexed[countKey] = count
+		if counts[countKey] == 0 {
+			keys = append(keys, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	corrections := []kvindexer.Correction{}
+	for _, key := range keys {
+		countKey := [2]string{string(key.K1()), string(key.K2())}
+		count, prev := counts[countKey], indexed[countKey]
+		if count == prev {
+			continue
+		}
+
+		if count == 0 {
+			err = idx.CollectionOwners.Remove(ctx, key)
+		} else {
+			err = idx.CollectionOwners.Set(ctx, key, count)
+		}
+		if err != nil {
+			return nil, err
+		}
+		corrections = append(corrections, kvindexer.Correction{
+			Target:   addrString(key.K1()) + "/" + addrString(key.K2()),
+			Field:    "owner_count",
+			OldValue: strconv.FormatUint(prev, 10),
+			NewValue: strconv.FormatUint(count, 10),
+		})
+	}
+
+	return corrections, nil
+}
+
+// Reconcile overwrites the fields of the collection with the non-empty fields of the authoritative one.
+// It returns the corrections made, targeting the given key.
+func (m *IndexedCollection) Reconcile(target string, authoritative *Collection) []kvindexer.Correction {
+	if m.Collection == nil {
+		m.Collection = &Collection{}
+	}
+	if m.Collection.Nfts == nil {
+		m.Collection.Nfts = &TokenHandle{}
+	}
+
+	corrections := []kvindexer.Correction{}
+	correct := func(field string, indexed *string, value string) {
+		corrections = appendCorrection(corrections, target, field, indexed, value)
+	}
+
+	correct("creator", &m.Collection.Creator, authoritative.Creator)
+	correct("description", &m.Collection.Description, authoritative.Description)
+	correct("name", &m.Collection.Name, authoritative.Name)
+	correct("uri", &m.Collection.Uri, authoritative.Uri)
+	if authoritative.Nfts != nil {
+		correct("nfts.handle", &m.Collection.Nfts.Handle, authoritative.Nfts.Handle)
+		correct("nfts.length", &m.Collection.Nfts.Length, authoritative.Nfts.Length)
+	}
+
+	return corrections
+}
+
+// Reconcile overwrites the metadata of the token with the non-empty fields of the authoritative one.
+// It returns the corrections made, targeting the given key. The owner is not reconciled here.
+func (m *IndexedToken) Reconcile(target string, authoritative *Token) []kvindexer.Correction {
+	if m.Nft == nil {
+		m.Nft = &Token{}
+	}
+
+	corrections := []kvindexer.Correction{}
+	correct := func(field string, indexed *string, value string) {
+		corrections = appendCorrection(corrections, target, field, indexed, value)
+	}
+
+	correct("description", &m.Nft.Description, authoritative.Description)
+	correct("uri", &m.Nft.Uri, authoritative.Uri)
+
+	return corrections
+}
+
+// appendCorrection sets the indexed value to the non-empty value and appends the correction if they differ.
+func appendCorrection(corrections []kvindexer.Correction, target, field string, indexed *string, value string) []kvindexer.Correction {
+	if value == "" || *indexed == value {
+		return corrections
+	}
+	corrections = append(corrections, kvindexer.Correction{
+		Target:   target,
+		Field:    field,
+		OldValue: *indexed,
+		NewValue: value,
+	})
+	*indexed = value
+	return corrections
+}
@@ -151,6 +151,9 @@ type IndexedToken struct {
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	OwnerAddr      string `protobuf:"bytes,4,opt,name=owner_addr,json=ownerAddr,proto3" json:"owner_addr,omitempty"`
 	Nft            *Token `protobuf:"bytes,5,opt,name=nft,proto3" json:"nft,omitempty"`
+	// amount is the balance of the owner, set for the multi-token standards
+	// such as ERC-1155 only
+	Amount string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
 func (m *IndexedToken) Reset()         { *m = IndexedToken{} }
@@ -277,36 +280,37 @@ func init() {
 func init() { proto.RegisterFile("indexer/nft/v1/types.proto", fileDescriptor_fd956bdc1b36fda7) }
 
 var fileDescriptor_fd956bdc1b36fda7 = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x69, 0xbb, 0xd1, 0x57, 0xb4, 0x81, 0x35, 0x50, 0x56, 0x84, 0x57, 0xe5, 0xb2, 0x09,
-	0x89, 0x44, 0x83, 0x1b, 0x12, 0x42, 0xc0, 0x85, 0x4a, 0x88, 0x43, 0xc4, 0x89, 0x4b, 0x95, 0xc4,
-	0x4e, 0x6a, 0x96, 0xda, 0x91, 0xe3, 0x15, 0xf8, 0x17, 0xdc, 0x38, 0x72, 0xe5, 0xa7, 0x8c, 0xdb,
-	0x8e, 0x1c, 0x21, 0xfd, 0x23, 0x28, 0xb6, 0xb7, 0xa6, 0xa5, 0x12, 0xdc, 0xde, 0xfb, 0xde, 0xe7,
-	0xef, 0x3d, 0x7f, 0x4f, 0x0f, 0x46, 0x5c, 0x50, 0xf6, 0x89, 0xa9, 0x50, 0x64, 0x3a, 0x5c, 0x9c,
-	0x86, 0xfa, 0x73, 0xc9, 0xaa, 0xa0, 0x54, 0x52, 0x4b, 0xbc, 0xe7, 0x6a, 0x81, 0xc8, 0x74, 0xb0,
-	0x38, 0x1d, 0x1d, 0xe4, 0x32, 0x97, 0xa6, 0x14, 0x36, 0x91, 0x65, 0x8d, 0x0e, 0x53, 0x59, 0xcd,
-	0x65, 0x35, 0xb5, 0x05, 0x9b, 0xd8, 0x92, 0x5f, 0xc2, 0x9d, 0x89, 0x91, 0xa0, 0xaf, 0x64, 0x51,
-	0xb0, 0x54, 0x73, 0x29, 0xf0, 0x11, 0x0c, 0x65, 0xf2, 0x81, 0xa5, 0x7a, 0x1a, 0x53, 0xaa, 0x3c,
-	0x34, 0x46, 0x27, 0x83, 0x08, 0x2c, 0xf4, 0x82, 0x52, 0x85, 0x9f, 0x02, 0xa4, 0xd7, 0x74, 0xef,
-	0xc6, 0x18, 0x9d, 0x0c, 0x1f, 0x8f, 0x82, 0xf5, 0x59, 0x82, 0x95, 0x60, 0xd4, 0x62, 0xfb, 0xdf,
-	0x10, 0x40, 0xab, 0x97, 0x07, 0xbb, 0xa9, 0x62, 0xb1, 0x96, 0x57, 0x7d, 0xae, 0x52, 0x3c, 0x86,
-	0x21, 0x65, 0x55, 0xaa, 0x78, 0x79, 0xdd, 0x65, 0x10, 0xb5, 0x21, 0x8c, 0xa1, 0x27, 0xe2, 0x39,
-	0xf3, 0xba, 0xa6, 0x64, 0x62, 0x7c, 0x1b, 0xba, 0xe7, 0x8a, 0x7b, 0x3d, 0x03, 0x35, 0x21, 0x0e,
-	0xa1, 0x27, 0x32, 0x5d, 0x79, 0x7d, 0x33, 0xe6, 0xfd, 0xcd, 0x31, 0xdf, 0xc9, 0x33, 0x26, 0x5e,
-	0xc7, 0x82, 0x16, 0x2c, 0x32, 0x44, 0xff, 0x19, 0x0c, 0x5b, 0x20, 0xbe, 0x07, 0x3b, 0x33, 0x13,
-	0xb9, 0x01, 0x5d, 0xd6, 0xe0, 0x05, 0x13, 0xb9, 0x9e, 0xb9, 0xd1, 0x5c, 0xe6, 0xff, 0x40, 0x70,
-	0xcb, 0x79, 0x6a, 0x64, 0xfe, 0x6d, 0xe7, 0x31, 0xec, 0xaf, 0x0c, 0xb2, 0x24, 0x2b, 0xb9, 0xb7,
-	0x82, 0xb7, 0x10, 0x5b, 0x7f, 0x6f, 0x11, 0xdf, 0x36, 0x2e, 0x3c, 0x00, 0x90, 0x1f, 0x05, 0x53,
-	0x56, 0xcc, 0x9a, 0x31, 0x30, 0x88, 0xd3, 0xe9, 0x8a, 0x4c, 0x3b, 0x47, 0xee, 0x6e, 0x75, 0x24,
-	0x6a, 0x18, 0xfe, 0x57, 0x04, 0x7d, 0xfb, 0x89, 0xe7, 0x6b, 0x2b, 0x47, 0xe6, 0xe5, 0xd1, 0xe6,
-	0xcb, 0x89, 0x10, 0x4c, 0x6d, 0xdf, 0xfb, 0x7f, 0xac, 0xf3, 0x10, 0x6e, 0xea, 0xa6, 0xd7, 0x94,
-	0x53, 0xf7, 0xad, 0x5d, 0x93, 0x4f, 0xe8, 0xdf, 0x5b, 0xf5, 0x8f, 0x61, 0x7f, 0xa3, 0x1b, 0x3e,
-	0x80, 0x3e, 0x6f, 0x20, 0xe7, 0xb0, 0x4d, 0x5e, 0xbe, 0xb9, 0xf8, 0x4d, 0x3a, 0xdf, 0x6b, 0x82,
-	0x2e, 0x6a, 0x82, 0x2e, 0x6b, 0x82, 0x7e, 0xd5, 0x04, 0x7d, 0x59, 0x92, 0xce, 0xe5, 0x92, 0x74,
-	0x7e, 0x2e, 0x49, 0xe7, 0xfd, 0xc3, 0x9c, 0xeb, 0xd9, 0x79, 0x12, 0xa4, 0x72, 0x1e, 0x72, 0xc1,
-	0x35, 0x8f, 0x1f, 0x15, 0x71, 0x52, 0x85, 0x67, 0x8b, 0xf6, 0xe5, 0x99, 0xb3, 0x4b, 0x76, 0xcc,
-	0xd9, 0x3c, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x19, 0x39, 0xc4, 0xc4, 0x95, 0x03, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0x92, 0x8f, 0x92, 0x09, 0x6a, 0x61, 0x55, 0x90, 0x1b, 0xc4, 0x36, 0xf2, 0xa5, 0x15,
+	0x12, 0xb6, 0x0a, 0x37, 0x24, 0x84, 0x80, 0x0b, 0x91, 0x10, 0x07, 0x8b, 0x13, 0x97, 0xc8, 0xf1,
+	0x6e, 0xe2, 0xa5, 0xf6, 0xae, 0xb5, 0xde, 0x04, 0xf8, 0x17, 0xdc, 0x38, 0x72, 0xe5, 0xa7, 0xf4,
+	0xd8, 0x23, 0x47, 0xea, 0xfc, 0x11, 0xe4, 0xdd, 0x6d, 0xe2, 0x86, 0x48, 0x70, 0x9b, 0x79, 0xf3,
+	0xf4, 0x66, 0xe6, 0xed, 0x0e, 0x0c, 0xb9, 0xa0, 0xec, 0x0b, 0x53, 0xa1, 0x98, 0xe9, 0x70, 0x79,
+	0x16, 0xea, 0xaf, 0x05, 0x2b, 0x83, 0x42, 0x49, 0x2d, 0xf1, 0xbe, 0xab, 0x05, 0x62, 0xa6, 0x83,
+	0xe5, 0xd9, 0xf0, 0x70, 0x2e, 0xe7, 0xd2, 0x94, 0xc2, 0x3a, 0xb2, 0xac, 0xe1, 0x51, 0x22, 0xcb,
+	0x5c, 0x96, 0x13, 0x5b, 0xb0, 0x89, 0x2d, 0xf9, 0x05, 0xdc, 0x1b, 0x1b, 0x09, 0xfa, 0x46, 0x66,
+	0x19, 0x4b, 0x34, 0x97, 0x02, 0x1f, 0xc3, 0x40, 0x4e, 0x3f, 0xb1, 0x44, 0x4f, 0x62, 0x4a, 0x95,
+	0x87, 0x46, 0xe8, 0xb4, 0x1f, 0x81, 0x85, 0x5e, 0x51, 0xaa, 0xf0, 0x73, 0x80, 0x64, 0x4d, 0xf7,
+	0x6e, 0x8d, 0xd0, 0xe9, 0xe0, 0xe9, 0x30, 0xb8, 0x39, 0x4b, 0xb0, 0x11, 0x8c, 0x1a, 0x6c, 0xff,
+	0x07, 0x02, 0x68, 0xf4, 0xf2, 0x60, 0x2f, 0x51, 0x2c, 0xd6, 0xf2, 0xba, 0xcf, 0x75, 0x8a, 0x47,
+	0x30, 0xa0, 0xac, 0x4c, 0x14, 0x2f, 0xd6, 0x5d, 0xfa, 0x51, 0x13, 0xc2, 0x18, 0x3a, 0x22, 0xce,
+	0x99, 0xd7, 0x36, 0x25, 0x13, 0xe3, 0xbb, 0xd0, 0x5e, 0x28, 0xee, 0x75, 0x0c, 0x54, 0x87, 0x38,
+	0x84, 0x8e, 0x98, 0xe9, 0xd2, 0xeb, 0x9a, 0x31, 0x1f, 0x6e, 0x8f, 0xf9, 0x41, 0x9e, 0x33, 0xf1,
+	0x36, 0x16, 0x34, 0x63, 0x91, 0x21, 0xfa, 0x2f, 0x60, 0xd0, 0x00, 0xf1, 0x03, 0xe8, 0xa5, 0x26,
+	0x72, 0x03, 0xf6, 0xd2, 0x35, 0x9e, 0x31, 0x31, 0xd7, 0xa9, 0x1b, 0xcd, 0x65, 0xfe, 0x15, 0x82,
+	0x3b, 0xce, 0x53, 0x23, 0xf3, 0x6f, 0x3b, 0x4f, 0xe0, 0x60, 0x63, 0x90, 0x25, 0x59, 0xc9, 0xfd,
+	0x0d, 0xbc, 0x83, 0xd8, 0xd8, 0xbd, 0x41, 0x7c, 0x5f, 0xbb, 0xf0, 0x08, 0x40, 0x7e, 0x16, 0x4c,
+	0x59, 0x31, 0x6b, 0x46, 0xdf, 0x20, 0x4e, 0xa7, 0x2d, 0x66, 0xda, 0x39, 0x72, 0x7f, 0xa7, 0x23,
+	0x51, 0xcd, 0xa8, 0x77, 0x8c, 0x73, 0xb9, 0x10, 0xda, 0xeb, 0xd9, 0x1d, 0x6d, 0xe6, 0x7f, 0x47,
+	0xd0, 0xb5, 0xcb, 0xbd, 0xbc, 0xf1, 0x15, 0x90, 0x51, 0x3c, 0xde, 0x56, 0x1c, 0x0b, 0xc1, 0xd4,
+	0xee, 0xff, 0xf0, 0x1f, 0xcf, 0x7c, 0x04, 0xb7, 0x75, 0xdd, 0x6b, 0xc2, 0xa9, 0x5b, 0x77, 0xcf,
+	0xe4, 0x63, 0xfa, 0xf7, 0x6b, 0xfb, 0x27, 0x70, 0xb0, 0xd5, 0x0d, 0x1f, 0x42, 0x97, 0xd7, 0x90,
+	0x73, 0xde, 0x26, 0xaf, 0xdf, 0x5d, 0x5c, 0x91, 0xd6, 0xcf, 0x8a, 0xa0, 0x8b, 0x8a, 0xa0, 0xcb,
+	0x8a, 0xa0, 0xdf, 0x15, 0x41, 0xdf, 0x56, 0xa4, 0x75, 0xb9, 0x22, 0xad, 0x5f, 0x2b, 0xd2, 0xfa,
+	0xf8, 0x78, 0xce, 0x75, 0xba, 0x98, 0x06, 0x89, 0xcc, 0x43, 0x2e, 0xb8, 0xe6, 0xf1, 0x93, 0x2c,
+	0x9e, 0x96, 0xe1, 0xf9, 0xb2, 0x79, 0x91, 0xe6, 0x1c, 0xa7, 0x3d, 0x73, 0x4e, 0xcf, 0xfe, 0x0c,
+	0x00, 0x05, 0x13, 0x92, 0xd2, 0xad, 0x03, 0x00, 0x00,
 }
 
 func (this *IndexedCollection) Equal(that interface{}) bool {
@@ -433,6 +437,9 @@ func (this *IndexedToken) Equal(that interface{}) bool {
 	if !this.Nft.Equal(that1.Nft) {
 		return false
 	}
+	if this.Amount != that1.Amount {
+		return false
+	}
 	return true
 }
 func (this *Token) Equal(that interface{}) bool {
@@ -654,6 +661,13 @@ func (m *IndexedToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Nft != nil {
 		{
 			size, err := m.Nft.MarshalToSizedBuffer(dAtA[:i])
@@ -883,6 +897,10 @@ func (m *IndexedToken) Size() (n int) {
 		l = m.Nft.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1569,6 +1587,38 @@ func (m *IndexedToken) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
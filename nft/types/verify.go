@@ -0,0 +1,132 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	cosmoserr "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	kvindexer "github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+// Verify checks that the tokens, token owners, collection owners and collections agree with each other.
+func (idx Indices) Verify(ctx context.Context, ac address.Codec) ([]kvindexer.InvariantViolation, error) {
+	violations := []kvindexer.InvariantViolation{}
+	addrString := func(addr sdk.AccAddress) string {
+		s, err := ac.BytesToString(addr)
+		if err != nil {
+			return fmt.Sprintf("%X", []byte(addr))
+		}
+		return s
+	}
+
+	// owned token counts from TokenOwners, key(owner address, collection address)
+	ownedCounts := make(map[[2]string]uint64)
+
+	// TokenOwners -> Tokens
+	err := idx.TokenOwners.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, string], _ bool) (bool, error) {
+		owner, collectionAddr := addrString(key.K1()), addrString(key.K2())
+		ownedCounts[[2]string{owner, collectionAddr}]++
+
+		token, err := idx.Tokens.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			if !cosmoserr.IsOf(err, collections.ErrNotFound) {
+				return true, err
+			}
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "token_owners",
+				Description: fmt.Sprintf("token %s of collection %s owned by %s not found", key.K3(), collectionAddr, owner),
+			})
+			return false, nil
+		}
+
+		if token.OwnerAddr != owner {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "token_owners",
+				Description: fmt.Sprintf("token %s of collection %s is owned by %s, but indexed for %s", key.K3(), collectionAddr, token.OwnerAddr, owner),
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens -> TokenOwners, Collections
+	err = idx.Tokens.Walk(ctx, nil, func(key TokenKey, token IndexedToken) (bool, error) {
+		collectionAddr := addrString(key.K1())
+
+		found, err := idx.Collections.Has(ctx, key.K1())
+		if err != nil {
+			return true, err
+		}
+		if !found {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "collections",
+				Description: fmt.Sprintf("collection %s of token %s not found", collectionAddr, key.K2()),
+			})
+		}
+
+		owner, err := ac.StringToBytes(token.OwnerAddr)
+		if err != nil {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "tokens",
+				Description: fmt.Sprintf("token %s of collection %s has invalid owner %s", key.K2(), collectionAddr, token.OwnerAddr),
+			})
+			return false, nil
+		}
+
+		found, err = idx.TokenOwners.Has(ctx, collections.Join3(sdk.AccAddress(owner), key.K1(), key.K2()))
+		if err != nil {
+			return true, err
+		}
+		if !found {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "token_owners",
+				Description: fmt.Sprintf("token %s of collection %s is not indexed for owner %s", key.K2(), collectionAddr, token.OwnerAddr),
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// CollectionOwners <-> TokenOwners
+	err = idx.CollectionOwners.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], count uint64) (bool, error) {
+		ownedKey := [2]string{addrString(key.K1()), addrString(key.K2())}
+		owned := ownedCounts[ownedKey]
+		delete(ownedCounts, ownedKey)
+
+		if count != owned {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "collection_owners",
+				Description: fmt.Sprintf("owner %s has %d tokens of collection %s, but the count is %d", ownedKey[0], owned, ownedKey[1], count),
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uncounted := make([][2]string, 0, len(ownedCounts))
+	for key := range ownedCounts {
+		uncounted = append(uncounted, key)
+	}
+	sort.Slice(uncounted, func(i, j int) bool {
+		return uncounted[i][0] < uncounted[j][0] || (uncounted[i][0] == uncounted[j][0] && uncounted[i][1] < uncounted[j][1])
+	})
+	for _, key := range uncounted {
+		violations = append(violations, kvindexer.InvariantViolation{
+			Invariant:   "collection_owners",
+			Description: fmt.Sprintf("owner %s has %d tokens of collection %s, but the count is missing", key[0], ownedCounts[key], key[1]),
+		})
+	}
+
+	return violations, nil
+}
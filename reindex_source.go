@@ -0,0 +1,77 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+var _ types.ReindexSource = ReindexSource{}
+
+// BlockClient loads the blocks and their results from the node. It's implemented by the cometbft rpc client,
+// e.g. client.Context.Client of the app's RegisterTxService.
+type BlockClient interface {
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// QueryContextCreator creates the query context of the state at the height. It's implemented by baseapp.BaseApp.
+type QueryContextCreator interface {
+	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+}
+
+// ReindexSource provides the blocks stored by the node and the states of the app to the admin service.
+// The node must keep the FinalizeBlock responses, i.e. storage.discard_abci_responses = false in config.toml.
+type ReindexSource struct {
+	client BlockClient
+	app    QueryContextCreator
+}
+
+// NewReindexSource returns a new ReindexSource. Set it to the keeper with SetReindexSource.
+func NewReindexSource(client BlockClient, app QueryContextCreator) ReindexSource {
+	return ReindexSource{client: client, app: app}
+}
+
+// FinalizeBlock implements types.ReindexSource.
+func (s ReindexSource) FinalizeBlock(height int64) (abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock, error) {
+	block, err := s.client.Block(context.Background(), &height)
+	if err != nil {
+		return abci.RequestFinalizeBlock{}, abci.ResponseFinalizeBlock{}, err
+	}
+	if block.Block == nil {
+		return abci.RequestFinalizeBlock{}, abci.ResponseFinalizeBlock{}, fmt.Errorf("block of height %d not found", height)
+	}
+
+	results, err := s.client.BlockResults(context.Background(), &height)
+	if err != nil {
+		return abci.RequestFinalizeBlock{}, abci.ResponseFinalizeBlock{}, err
+	}
+
+	req := abci.RequestFinalizeBlock{
+		Txs:                block.Block.Txs.ToSliceOfBytes(),
+		Hash:               block.Block.Hash(),
+		Height:             block.Block.Height,
+		Time:               block.Block.Time,
+		NextValidatorsHash: block.Block.NextValidatorsHash,
+		ProposerAddress:    block.Block.ProposerAddress,
+	}
+	res := abci.ResponseFinalizeBlock{
+		Events:                results.FinalizeBlockEvents,
+		TxResults:             results.TxsResults,
+		ValidatorUpdates:      results.ValidatorUpdates,
+		ConsensusParamUpdates: results.ConsensusParamUpdates,
+		AppHash:               results.AppHash,
+	}
+
+	return req, res, nil
+}
+
+// QueryContext implements types.ReindexSource.
+func (s ReindexSource) QueryContext(height int64) (context.Context, error) {
+	return s.app.CreateQueryContext(height, false)
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
 	corestoretypes "cosmossdk.io/core/store"
 	cachekv "cosmossdk.io/store/cachekv"
@@ -12,8 +14,15 @@ import (
 var _ corestoretypes.KVStore = (*CacheStore)(nil)
 
 type CacheStore struct {
-	store storetypes.CacheKVStore
-	cache *bigcache.BigCache
+	parent storetypes.KVStore
+	store  *storetypes.CacheKVStore
+	cache  *bigcache.BigCache
+	// mtx guards replacing the store on Discard against the lookups filling the cache
+	mtx *sync.RWMutex
+
+	// hits and misses count the lookups of the cache since the last CacheStats call
+	hits   *atomic.Uint64
+	misses *atomic.Uint64
 }
 
 func NewCacheStore(store storetypes.KVStore, capacity int) *CacheStore {
@@ -27,9 +36,15 @@ func NewCacheStore(store storetypes.KVStore, capacity int) *CacheStore {
 		panic(err)
 	}
 
+	cacheKV := storetypes.CacheKVStore(cachekv.NewStore(store))
 	return &CacheStore{
-		store: cachekv.NewStore(store),
-		cache: cache,
+		parent: store,
+		store:  &cacheKV,
+		cache:  cache,
+		mtx:    &sync.RWMutex{},
+
+		hits:   &atomic.Uint64{},
+		misses: &atomic.Uint64{},
 	}
 }
 
@@ -37,12 +52,17 @@ func NewCacheStore(store storetypes.KVStore, capacity int) *CacheStore {
 func (c CacheStore) Get(key []byte) ([]byte, error) {
 	storetypes.AssertValidKey(key)
 
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
 	if value, err := c.cache.Get(string(key)); err == nil {
+		c.hits.Add(1)
 		return value, nil
 	}
+	c.misses.Add(1)
 
 	// get from store and write to cache
-	value := c.store.Get(key)
+	value := (*c.store).Get(key)
 	if value == nil {
 		return nil, nil
 	}
@@ -55,12 +75,17 @@ func (c CacheStore) Get(key []byte) ([]byte, error) {
 
 // Has checks if a key exists. Errors on nil key.
 func (c CacheStore) Has(key []byte) (bool, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
 	_, err := c.cache.Get(string(key))
 	if err == nil {
+		c.hits.Add(1)
 		return true, nil
 	}
+	c.misses.Add(1)
 
-	value := c.store.Get(key)
+	value := (*c.store).Get(key)
 	if value == nil {
 		return false, nil
 	}
@@ -76,9 +101,12 @@ func (c CacheStore) Set(key, value []byte) error {
 	storetypes.AssertValidKey(key)
 	storetypes.AssertValidValue(value)
 
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
 	// ignore cache error
 	_ = c.cache.Set(string(key), value)
-	c.store.Set(key, value)
+	(*c.store).Set(key, value)
 
 	return nil
 }
@@ -87,9 +115,12 @@ func (c CacheStore) Set(key, value []byte) error {
 func (c CacheStore) Delete(key []byte) error {
 	storetypes.AssertValidKey(key)
 
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
 	// ignore cache error
 	_ = c.cache.Delete(string(key))
-	c.store.Delete(key)
+	(*c.store).Delete(key)
 
 	return nil
 }
@@ -101,7 +132,10 @@ func (c CacheStore) Delete(key []byte) error {
 // CONTRACT: No writes may happen within a domain while an iterator exists over it.
 // Exceptionally allowed for cachekv.Store, safe to write in the modules.
 func (c CacheStore) Iterator(start, end []byte) (storetypes.Iterator, error) {
-	return c.store.Iterator(start, end), nil
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return (*c.store).Iterator(start, end), nil
 }
 
 // ReverseIterator iterates over a domain of keys in descending order. End is exclusive.
@@ -110,9 +144,30 @@ func (c CacheStore) Iterator(start, end []byte) (storetypes.Iterator, error) {
 // CONTRACT: No writes may happen within a domain while an iterator exists over it.
 // Exceptionally allowed for cachekv.Store, safe to write in the modules.
 func (c CacheStore) ReverseIterator(start, end []byte) (storetypes.Iterator, error) {
-	return c.store.ReverseIterator(start, end), nil
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return (*c.store).ReverseIterator(start, end), nil
 }
 
 func (c CacheStore) Write() {
-	c.store.Write()
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	(*c.store).Write()
+}
+
+// Discard drops the writes not written to the parent store yet. The cache is reset as it holds them as well.
+func (c CacheStore) Discard() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	*c.store = cachekv.NewStore(c.parent)
+	// ignore cache error
+	_ = c.cache.Reset()
+}
+
+// CacheStats returns the number of cache hits and misses since the last call and resets them.
+func (c CacheStore) CacheStats() (hits, misses uint64) {
+	return c.hits.Swap(0), c.misses.Swap(0)
 }
@@ -21,6 +21,7 @@ import (
 )
 
 var _ kvindexer.Submodule = BlockSubmodule{}
+var _ kvindexer.Verifier = BlockSubmodule{}
 
 type BlockSubmodule struct {
 	cdc codec.Codec
@@ -89,3 +90,7 @@ func (sub BlockSubmodule) Commit(ctx context.Context, res abci.ResponseCommit, c
 func (sub BlockSubmodule) Prune(ctx context.Context, minHeight int64) error {
 	return sub.prune(ctx, minHeight)
 }
+
+func (sub BlockSubmodule) Verify(ctx context.Context) ([]kvindexer.InvariantViolation, error) {
+	return sub.verify(ctx)
+}
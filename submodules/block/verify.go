@@ -0,0 +1,44 @@
+package block
+
+import (
+	"context"
+	"fmt"
+
+	kvindexer "github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+// verify checks that the heights of blockByHeight are contiguous and every block matches its key.
+// lower heights may be missing due to pruning, so only gaps between the indexed blocks are reported.
+func (sub BlockSubmodule) verify(ctx context.Context) ([]kvindexer.InvariantViolation, error) {
+	violations := []kvindexer.InvariantViolation{}
+
+	iter, err := sub.blockByHeight.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	prev := int64(0)
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+
+		if kv.Value.Height != kv.Key {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "block_height",
+				Description: fmt.Sprintf("block at height %d has height %d", kv.Key, kv.Value.Height),
+			})
+		}
+		if prev != 0 && kv.Key != prev+1 {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "block_gap",
+				Description: fmt.Sprintf("blocks from height %d to %d are missing", prev+1, kv.Key-1),
+			})
+		}
+		prev = kv.Key
+	}
+
+	return violations, nil
+}
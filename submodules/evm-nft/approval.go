@@ -0,0 +1,69 @@
+package evm_nft
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/submodules/evm-nft/types"
+)
+
+// approvalKey returns the key of the approval in approvalMap.
+// the token id identifies the token approval as only one spender is approved per token.
+func approvalKey(owner, contract sdk.AccAddress, approvalType types.ApprovalType, target string) collections.Triple[sdk.AccAddress, sdk.AccAddress, collections.Pair[int32, string]] {
+	return collections.Join3(owner, contract, collections.Join(int32(approvalType), target))
+}
+
+func (sm EvmNFTSubmodule) handleApprovalEvent(ctx context.Context, height int64, event *types.ParsedApproval) error {
+	sm.Logger(ctx).Debug("approved", "event", event)
+	contractSdkAddr := getCosmosAddress(event.Address)
+
+	target := event.Spender.String()
+	if event.Type == types.ApprovalTypeERC721Token {
+		target = event.TokenId
+	}
+	key := approvalKey(event.Owner, contractSdkAddr, event.Type, target)
+
+	if !event.Approved {
+		if err := sm.approvalMap.Remove(ctx, key); err != nil {
+			return cosmoserr.Wrap(err, "failed to remove approval")
+		}
+		if event.Type == types.ApprovalTypeOperator {
+			if err := sm.operatorMap.Remove(ctx, collections.Join3(contractSdkAddr, event.Spender, event.Owner)); err != nil {
+				return cosmoserr.Wrap(err, "failed to remove operator")
+			}
+		}
+		return nil
+	}
+
+	approval := types.Approval{
+		Type:     event.Type,
+		Contract: contractSdkAddr.String(),
+		Owner:    event.Owner.String(),
+		Spender:  event.Spender.String(),
+		TokenId:  event.TokenId,
+		Amount:   event.Amount,
+		Height:   height,
+	}
+	if err := sm.approvalMap.Set(ctx, key, approval); err != nil {
+		return cosmoserr.Wrap(err, "failed to set approval")
+	}
+	if event.Type == types.ApprovalTypeOperator {
+		if err := sm.operatorMap.Set(ctx, collections.Join3(contractSdkAddr, event.Spender, event.Owner), approval); err != nil {
+			return cosmoserr.Wrap(err, "failed to set operator")
+		}
+	}
+
+	return nil
+}
+
+// clearTokenApproval removes the approval of the token granted by the previous owner,
+// which is cleared by the contract on transfer and burn.
+func (sm EvmNFTSubmodule) clearTokenApproval(ctx context.Context, owner, contract sdk.AccAddress, tokenId string) error {
+	if err := sm.approvalMap.Remove(ctx, approvalKey(owner, contract, types.ApprovalTypeERC721Token, tokenId)); err != nil {
+		return cosmoserr.Wrap(err, "failed to clear token approval")
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package evm_nft
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	nfttypes "github.com/initia-labs/kvindexer/nft/types"
+	"github.com/initia-labs/kvindexer/submodules/evm-nft/types"
+)
+
+func (sm EvmNFTSubmodule) handleMultiTransferEvent(ctx context.Context, event types.ParsedMultiTransfer) error {
+	sm.Logger(ctx).Debug("multi-token transferred", "event", event)
+	if !event.Amount.IsPositive() {
+		return nil
+	}
+
+	contractSdkAddr := getCosmosAddress(event.Address)
+	emptyAddr := getCosmosAddress(common.Address{})
+
+	// minting has no sender and burning has no recipient
+	if !event.From.Equals(emptyAddr) {
+		if err := sm.applyBalance(ctx, event.From, contractSdkAddr, event.TokenId, event.Amount.Neg()); err != nil {
+			return cosmoserr.Wrap(err, "failed to decrease balance of sender")
+		}
+	}
+	if !event.To.Equals(emptyAddr) {
+		if err := sm.applyBalance(ctx, event.To, contractSdkAddr, event.TokenId, event.Amount); err != nil {
+			return cosmoserr.Wrap(err, "failed to increase balance of recipient")
+		}
+	}
+
+	return nil
+}
+
+// applyBalance adds the delta to the balance of the owner, and removes the balance if it's drained.
+func (sm EvmNFTSubmodule) applyBalance(ctx context.Context, ownerAddr, collectionAddr sdk.AccAddress, tokenId string, delta math.Int) error {
+	key := collections.Join3(ownerAddr, collectionAddr, tokenId)
+	holderKey := collections.Join3(collectionAddr, tokenId, ownerAddr)
+
+	token, err := sm.balanceMap.Get(ctx, key)
+	if err != nil {
+		if !cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return err
+		}
+		// token uri isn't available as ERC-1155 collections aren't registered to the VM
+		token = nfttypes.IndexedToken{
+			CollectionAddr: collectionAddr.String(),
+			OwnerAddr:      ownerAddr.String(),
+			Nft:            &nfttypes.Token{TokenId: tokenId},
+			Amount:         "0",
+		}
+	}
+
+	amount, ok := math.NewIntFromString(token.Amount)
+	if !ok {
+		return errors.Errorf("invalid amount of the balance: %s", token.Amount)
+	}
+	amount = amount.Add(delta)
+
+	// the balance can be negative if the indexing started after the tokens were minted
+	if !amount.IsPositive() {
+		if err := sm.balanceMap.Remove(ctx, key); err != nil {
+			return err
+		}
+		return sm.holderMap.Remove(ctx, holderKey)
+	}
+
+	token.Amount = amount.String()
+	if err := sm.balanceMap.Set(ctx, key, token); err != nil {
+		return err
+	}
+	return sm.holderMap.Set(ctx, holderKey, token)
+}
@@ -21,7 +21,7 @@ func (sm EvmNFTSubmodule) finalizeBlock(ctx context.Context, req abci.RequestFin
 
 	for _, txResult := range res.TxResults {
 		events := filterAndParseEvent(txResult.Events, eventTypes)
-		err := sm.processEvents(ctx, events)
+		err := sm.processEvents(ctx, req.Height, events)
 		if err != nil {
 			sm.Logger(ctx).Debug("processEvents", "error", err)
 		}
@@ -30,7 +30,7 @@ func (sm EvmNFTSubmodule) finalizeBlock(ctx context.Context, req abci.RequestFin
 	return nil
 }
 
-func (sm EvmNFTSubmodule) processEvents(ctx context.Context, events []types.EventWithAttributeMap) error {
+func (sm EvmNFTSubmodule) processEvents(ctx context.Context, height int64, events []types.EventWithAttributeMap) error {
 	for _, event := range events {
 		log, ok := event.AttributesMap[evmtypes.AttributeKeyLog]
 		if !ok {
@@ -38,13 +38,23 @@ func (sm EvmNFTSubmodule) processEvents(ctx context.Context, events []types.Even
 		}
 
 		transferLog, err := types.ParseERC721TransferLog(sm.ac, log)
+		if errors.Is(err, types.ErrNotERC721) {
+			sm.processNonERC721Log(ctx, height, log)
+			continue
+		}
 		if err != nil {
-			if !errors.Is(err, types.ErrNotERC721) {
-				sm.Logger(ctx).Info("failed parse attribute", "error", err)
-			}
+			sm.Logger(ctx).Info("failed parse attribute", "error", err)
 			continue
 		}
 
+		// the contract clears the approval of the token on transfer without emitting Approval,
+		// so it's cleared even if the token isn't indexed
+		if transferLog.GetAction() != types.NftActionMint {
+			if err := sm.clearTokenApproval(ctx, transferLog.From, getCosmosAddress(transferLog.Address), transferLog.TokenId); err != nil {
+				sm.Logger(ctx).Info("failed to clear token approval", "error", err.Error())
+			}
+		}
+
 		var fn func(context.Context, *types.ParsedTransfer) error
 		switch transferLog.GetAction() {
 		case types.NftActionMint:
@@ -65,6 +75,35 @@ func (sm EvmNFTSubmodule) processEvents(ctx context.Context, events []types.Even
 	return nil
 }
 
+// processNonERC721Log handles the ERC-1155 transfers and the approvals
+func (sm EvmNFTSubmodule) processNonERC721Log(ctx context.Context, height int64, log string) {
+	multiTransfers, err := types.ParseERC1155TransferLog(sm.ac, log)
+	if err == nil {
+		for _, transfer := range multiTransfers {
+			if err := sm.handleMultiTransferEvent(ctx, transfer); err != nil {
+				sm.Logger(ctx).Info("failed to handle erc1155 transfer event", "error", err.Error())
+			}
+		}
+		return
+	}
+	if !errors.Is(err, types.ErrNotERC1155) {
+		sm.Logger(ctx).Info("failed parse attribute", "error", err)
+		return
+	}
+
+	approvalLog, err := types.ParseApprovalLog(sm.ac, log)
+	if err != nil {
+		if !errors.Is(err, types.ErrNotApproval) {
+			sm.Logger(ctx).Info("failed parse attribute", "error", err)
+		}
+		return
+	}
+
+	if err := sm.handleApprovalEvent(ctx, height, approvalLog); err != nil {
+		sm.Logger(ctx).Info("failed to handle approval event", "error", err.Error())
+	}
+}
+
 func (sm EvmNFTSubmodule) handleMintEvent(ctx context.Context, event *types.ParsedTransfer) error {
 	sm.Logger(ctx).Debug("minted", "event", event)
 
@@ -0,0 +1,158 @@
+package evm_nft
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	kvcollection "github.com/initia-labs/kvindexer/collection"
+	nfttypes "github.com/initia-labs/kvindexer/nft/types"
+	"github.com/initia-labs/kvindexer/submodules/evm-nft/types"
+	"github.com/initia-labs/kvindexer/util"
+)
+
+var _ types.QueryServer = (*EvmQuerier)(nil)
+
+// EvmQuerier serves the queries of the approvals and the ERC-1155 balances, which are specific to the EVM
+type EvmQuerier struct {
+	EvmNFTSubmodule
+}
+
+func NewEvmQuerier(mn EvmNFTSubmodule) types.QueryServer {
+	return EvmQuerier{mn}
+}
+
+// ApprovalsByAccount implements types.QueryServer.
+func (q EvmQuerier) ApprovalsByAccount(ctx context.Context, req *types.QueryApprovalsByAccountRequest) (*types.QueryApprovalsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.Account == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty account")
+	}
+
+	ownerSdkAddr, err := getCosmosAddressFromString(q.ac, req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	opt := kvcollection.WithCollectionPaginationTriplePrefix[sdk.AccAddress, sdk.AccAddress, collections.Pair[int32, string]](ownerSdkAddr)
+	if req.Contract != "" {
+		contractSdkAddr, err := getCosmosAddressFromString(q.ac, req.Contract)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opt = kvcollection.WithCollectionPaginationTriplePrefix2[sdk.AccAddress, sdk.AccAddress, collections.Pair[int32, string]](ownerSdkAddr, contractSdkAddr)
+	}
+
+	approvals, pageRes, err := query.CollectionPaginate(ctx, q.approvalMap, req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, sdk.AccAddress, collections.Pair[int32, string]], approval types.Approval) (types.Approval, error) {
+			return approval, nil
+		},
+		opt,
+	)
+	if err != nil {
+		return nil, handleCollectionErr(err)
+	}
+
+	return &types.QueryApprovalsResponse{
+		Approvals:  approvals,
+		Pagination: pageRes,
+	}, nil
+}
+
+// OperatorsByCollection implements types.QueryServer.
+func (q EvmQuerier) OperatorsByCollection(ctx context.Context, req *types.QueryOperatorsByCollectionRequest) (*types.QueryApprovalsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.Collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty collection")
+	}
+
+	collectionSdkAddr, err := getCosmosAddressFromString(q.ac, req.Collection)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	approvals, pageRes, err := query.CollectionPaginate(ctx, q.operatorMap, req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress], approval types.Approval) (types.Approval, error) {
+			return approval, nil
+		},
+		kvcollection.WithCollectionPaginationTriplePrefix[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress](collectionSdkAddr),
+	)
+	if err != nil {
+		return nil, handleCollectionErr(err)
+	}
+
+	return &types.QueryApprovalsResponse{
+		Approvals:  approvals,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenBalancesByAccount implements types.QueryServer.
+func (q EvmQuerier) TokenBalancesByAccount(ctx context.Context, req *types.QueryTokenBalancesByAccountRequest) (*types.QueryTokenBalancesResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.Account == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty account")
+	}
+
+	ownerSdkAddr, err := getCosmosAddressFromString(q.ac, req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	opt := kvcollection.WithCollectionPaginationTriplePrefix[sdk.AccAddress, sdk.AccAddress, string](ownerSdkAddr)
+	if req.CollectionAddr != "" {
+		collectionSdkAddr, err := getCosmosAddressFromString(q.ac, req.CollectionAddr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opt = kvcollection.WithCollectionPaginationTriplePrefix2[sdk.AccAddress, sdk.AccAddress, string](ownerSdkAddr, collectionSdkAddr)
+	}
+
+	tokens, pageRes, err := query.CollectionPaginate(ctx, q.balanceMap, req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, sdk.AccAddress, string], token nfttypes.IndexedToken) (*nfttypes.IndexedToken, error) {
+			return &token, nil
+		},
+		opt,
+	)
+	if err != nil {
+		return nil, handleCollectionErr(err)
+	}
+
+	return &types.QueryTokenBalancesResponse{
+		Tokens:     tokens,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenBalancesByToken implements types.QueryServer.
+func (q EvmQuerier) TokenBalancesByToken(ctx context.Context, req *types.QueryTokenBalancesByTokenRequest) (*types.QueryTokenBalancesResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.CollectionAddr == "" || req.TokenId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty collection address or token id")
+	}
+
+	collectionSdkAddr, err := getCosmosAddressFromString(q.ac, req.CollectionAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokens, pageRes, err := query.CollectionPaginate(ctx, q.holderMap, req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, string, sdk.AccAddress], token nfttypes.IndexedToken) (*nfttypes.IndexedToken, error) {
+			return &token, nil
+		},
+		kvcollection.WithCollectionPaginationTriplePrefix2[sdk.AccAddress, string, sdk.AccAddress](collectionSdkAddr, req.TokenId),
+	)
+	if err != nil {
+		return nil, handleCollectionErr(err)
+	}
+
+	return &types.QueryTokenBalancesResponse{
+		Tokens:     tokens,
+		Pagination: pageRes,
+	}, nil
+}
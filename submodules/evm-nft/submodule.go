@@ -2,6 +2,7 @@ package evm_nft
 
 import (
 	"context"
+	"sync/atomic"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -25,6 +26,9 @@ import (
 )
 
 var _ kvindexer.Submodule = EvmNFTSubmodule{}
+var _ kvindexer.Verifier = EvmNFTSubmodule{}
+var _ kvindexer.Reconciler = EvmNFTSubmodule{}
+var _ kvindexer.MigrationStatus = EvmNFTSubmodule{}
 
 type EvmNFTSubmodule struct {
 	ac  address.Codec
@@ -43,8 +47,18 @@ type EvmNFTSubmodule struct {
 	tokenMap *collections.Map[collections.Pair[sdk.AccAddress, string], nfttypes.IndexedToken]
 	// tokenOwnerMap: key(owner address, collection address, token id), value(bool as placeholder)
 	tokenOwnerMap *collections.Map[collections.Triple[sdk.AccAddress, sdk.AccAddress, string], bool]
+	// approvalMap: key(owner address, contract address, (approval type, token id or spender address)), value(approval)
+	approvalMap *collections.Map[collections.Triple[sdk.AccAddress, sdk.AccAddress, collections.Pair[int32, string]], types.Approval]
+	// operatorMap: key(collection address, operator address, owner address), value(approval)
+	operatorMap *collections.Map[collections.Triple[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress], types.Approval]
+	// balanceMap: key(owner address, collection address, token id), value(token with the balance of the owner)
+	balanceMap *collections.Map[collections.Triple[sdk.AccAddress, sdk.AccAddress, string], nfttypes.IndexedToken]
+	// holderMap: key(collection address, token id, owner address), value(token with the balance of the owner)
+	holderMap *collections.Map[collections.Triple[sdk.AccAddress, string, sdk.AccAddress], nfttypes.IndexedToken]
 	// migrationInfo stores json and internal use only
 	migrationInfo *collections.Map[string, string]
+	// migrating is true while the migration is running
+	migrating *atomic.Bool
 }
 
 func NewEvmNFTSubmodule(
@@ -84,6 +98,30 @@ func NewEvmNFTSubmodule(
 		return nil, err
 	}
 
+	approvalsPrefix := collection.NewPrefix(types.SubmoduleName, types.ApprovalsPrefix)
+	approvalMap, err := collection.AddMap(indexerKeeper, approvalsPrefix, "approvals", collections.TripleKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey, collections.PairKeyCodec(collections.Int32Key, collections.StringKey)), codec.CollValue[types.Approval](cdc))
+	if err != nil {
+		return nil, err
+	}
+
+	operatorsPrefix := collection.NewPrefix(types.SubmoduleName, types.OperatorsPrefix)
+	operatorMap, err := collection.AddMap(indexerKeeper, operatorsPrefix, "operators", collections.TripleKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey, sdk.AccAddressKey), codec.CollValue[types.Approval](cdc))
+	if err != nil {
+		return nil, err
+	}
+
+	balancesPrefix := collection.NewPrefix(types.SubmoduleName, types.BalancesPrefix)
+	balanceMap, err := collection.AddMap(indexerKeeper, balancesPrefix, "balances", collections.TripleKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey, collections.StringKey), codec.CollValue[nfttypes.IndexedToken](cdc))
+	if err != nil {
+		return nil, err
+	}
+
+	holdersPrefix := collection.NewPrefix(types.SubmoduleName, types.HoldersPrefix)
+	holderMap, err := collection.AddMap(indexerKeeper, holdersPrefix, "holders", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, sdk.AccAddressKey), codec.CollValue[nfttypes.IndexedToken](cdc))
+	if err != nil {
+		return nil, err
+	}
+
 	migrationPrefix := collection.NewPrefix(string(types.SubmoduleName), types.MigrationPrefix)
 	migrationMap, err := collection.AddMap(indexerKeeper, migrationPrefix, "migration", collections.StringKey, collections.StringValue)
 	if err != nil {
@@ -102,7 +140,12 @@ func NewEvmNFTSubmodule(
 		collectionNameMap:  collectionNameMap,
 		tokenMap:           tokenMap,
 		tokenOwnerMap:      tokenOwnerMap,
+		approvalMap:        approvalMap,
+		operatorMap:        operatorMap,
+		balanceMap:         balanceMap,
+		holderMap:          holderMap,
 		migrationInfo:      migrationMap,
+		migrating:          &atomic.Bool{},
 	}, nil
 }
 
@@ -121,11 +164,15 @@ func (sub EvmNFTSubmodule) Version() string {
 }
 
 func (sub EvmNFTSubmodule) RegisterQueryHandlerClient(cc client.Context, mux *runtime.ServeMux) error {
-	return nfttypes.RegisterQueryHandlerClient(context.Background(), mux, nfttypes.NewQueryClient(cc))
+	if err := nfttypes.RegisterQueryHandlerClient(context.Background(), mux, nfttypes.NewQueryClient(cc)); err != nil {
+		return err
+	}
+	return types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(cc))
 }
 
 func (sub EvmNFTSubmodule) RegisterQueryServer(s grpc.Server) {
 	nfttypes.RegisterQueryServer(s, NewQuerier(sub))
+	types.RegisterQueryServer(s, NewEvmQuerier(sub))
 }
 
 func (sub EvmNFTSubmodule) Prepare(ctx context.Context) error {
@@ -151,3 +198,25 @@ func (sub EvmNFTSubmodule) Commit(ctx context.Context, res abci.ResponseCommit,
 func (sub EvmNFTSubmodule) Prune(ctx context.Context, minHeight int64) error {
 	return nil
 }
+
+func (sub EvmNFTSubmodule) Verify(ctx context.Context) ([]kvindexer.InvariantViolation, error) {
+	return sub.indices().Verify(ctx, sub.ac)
+}
+
+// indices returns the indices shared by the nft submodules.
+func (sub EvmNFTSubmodule) indices() nfttypes.Indices {
+	return nfttypes.Indices{
+		Collections:      sub.collectionMap,
+		CollectionOwners: sub.collectionOwnerMap,
+		Tokens:           sub.tokenMap,
+		TokenOwners:      sub.tokenOwnerMap,
+	}
+}
+
+func (sub EvmNFTSubmodule) Reconcile(ctx context.Context) ([]kvindexer.Correction, []kvindexer.ReconcileFailure, error) {
+	return sub.indices().Reconcile(ctx, sub.ac, sub.getAuthoritativeToken, sub.getAuthoritativeCollection)
+}
+
+func (sub EvmNFTSubmodule) IsMigrating() bool {
+	return sub.migrating.Load()
+}
@@ -0,0 +1,285 @@
+package evm_nft
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/initia-labs/minievm/x/evm/types"
+
+	"github.com/initia-labs/kvindexer/submodules/evm-nft/types"
+)
+
+// testKeeper is the collection.IndexerKeeper of the submodule under test
+type testKeeper struct {
+	sb *collections.SchemaBuilder
+	ac address.Codec
+}
+
+func (k testKeeper) IsSealed() bool                               { return false }
+func (k testKeeper) GetSchemaBuilder() *collections.SchemaBuilder { return k.sb }
+func (k testKeeper) GetAddressCodec() address.Codec               { return k.ac }
+
+// memStore is the in-memory store of the submodule under test
+type memStore struct {
+	dbm.DB
+}
+
+func (s memStore) Iterator(start, end []byte) (corestore.Iterator, error) {
+	return s.DB.Iterator(start, end)
+}
+
+func (s memStore) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	return s.DB.ReverseIterator(start, end)
+}
+
+func newTestSubmodule(t *testing.T) (*EvmNFTSubmodule, context.Context) {
+	t.Helper()
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	kvStore := memStore{dbm.NewMemDB()}
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) corestore.KVStore { return kvStore })
+	ac := addresscodec.NewBech32Codec("init")
+
+	sm, err := NewEvmNFTSubmodule(ac, cdc, testKeeper{sb: sb, ac: ac}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sb.Build(); err != nil {
+		t.Fatal(err)
+	}
+
+	return sm, sdk.Context{}.WithContext(context.Background()).WithLogger(log.NewNopLogger())
+}
+
+func testAddress(i byte) sdk.AccAddress {
+	return sdk.AccAddress(append(make([]byte, 19), i))
+}
+
+// addressTopic returns the address left padded to 32 bytes as in the topics
+func addressTopic(addr sdk.AccAddress) string {
+	return "0x" + hex.EncodeToString(common.BytesToHash(addr).Bytes())
+}
+
+func wordTopic(v uint64) string {
+	return fmt.Sprintf("0x%064x", v)
+}
+
+// logEvent returns the evm event of the log emitted by the contract
+func logEvent(t *testing.T, contract sdk.AccAddress, topics []string, data string) types.EventWithAttributeMap {
+	t.Helper()
+
+	bz, err := json.Marshal(types.TransferLog{Address: common.BytesToAddress(contract).Hex(), Topics: topics, Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.EventWithAttributeMap{
+		Event:         &abci.Event{Type: evmtypes.EventTypeEVM},
+		AttributesMap: map[string]string{evmtypes.AttributeKeyLog: string(bz)},
+	}
+}
+
+func TestHandleApprovalEvent(t *testing.T) {
+	contract, owner, spender := testAddress(1), testAddress(2), testAddress(3)
+
+	tests := []struct {
+		name   string
+		events []types.ParsedApproval
+		// approvals and operators are the numbers of the entries left
+		approvals, operators int
+	}{
+		{
+			name:      "token approval",
+			events:    []types.ParsedApproval{{Type: types.ApprovalTypeERC721Token, Owner: owner, Spender: spender, TokenId: "1", Approved: true}},
+			approvals: 1,
+		},
+		{
+			name: "token approval replaced by another spender",
+			events: []types.ParsedApproval{
+				{Type: types.ApprovalTypeERC721Token, Owner: owner, Spender: spender, TokenId: "1", Approved: true},
+				{Type: types.ApprovalTypeERC721Token, Owner: owner, Spender: testAddress(4), TokenId: "1", Approved: true},
+			},
+			approvals: 1,
+		},
+		{
+			name: "token approval cleared",
+			events: []types.ParsedApproval{
+				{Type: types.ApprovalTypeERC721Token, Owner: owner, Spender: spender, TokenId: "1", Approved: true},
+				{Type: types.ApprovalTypeERC721Token, Owner: owner, Spender: getCosmosAddress(common.Address{}), TokenId: "1"},
+			},
+		},
+		{
+			name: "allowances of the spenders",
+			events: []types.ParsedApproval{
+				{Type: types.ApprovalTypeERC20Allowance, Owner: owner, Spender: spender, Amount: math.NewInt(10), Approved: true},
+				{Type: types.ApprovalTypeERC20Allowance, Owner: owner, Spender: testAddress(4), Amount: math.NewInt(20), Approved: true},
+			},
+			approvals: 2,
+		},
+		{
+			name: "allowance revoked",
+			events: []types.ParsedApproval{
+				{Type: types.ApprovalTypeERC20Allowance, Owner: owner, Spender: spender, Amount: math.NewInt(10), Approved: true},
+				{Type: types.ApprovalTypeERC20Allowance, Owner: owner, Spender: spender, Amount: math.ZeroInt()},
+			},
+		},
+		{
+			name:      "operator approved",
+			events:    []types.ParsedApproval{{Type: types.ApprovalTypeOperator, Owner: owner, Spender: spender, Approved: true}},
+			approvals: 1,
+			operators: 1,
+		},
+		{
+			name: "operator revoked",
+			events: []types.ParsedApproval{
+				{Type: types.ApprovalTypeOperator, Owner: owner, Spender: spender, Approved: true},
+				{Type: types.ApprovalTypeOperator, Owner: owner, Spender: spender},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sm, ctx := newTestSubmodule(t)
+			for i, event := range tc.events {
+				event.Address = common.BytesToAddress(contract)
+				if event.Amount.IsNil() {
+					event.Amount = math.ZeroInt()
+				}
+				if err := sm.handleApprovalEvent(ctx, int64(i+1), &event); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if n := count(t, ctx, sm.approvalMap); n != tc.approvals {
+				t.Errorf("got %d approvals, want %d", n, tc.approvals)
+			}
+			if n := count(t, ctx, sm.operatorMap); n != tc.operators {
+				t.Errorf("got %d operators, want %d", n, tc.operators)
+			}
+		})
+	}
+}
+
+func TestApplyBalance(t *testing.T) {
+	collection, owner := testAddress(1), testAddress(2)
+
+	tests := []struct {
+		name   string
+		deltas []int64
+		// amount is the balance left, and zero means no balance and no holder
+		amount int64
+	}{
+		{"received", []int64{10}, 10},
+		{"received and sent", []int64{10, -4}, 6},
+		{"drained", []int64{10, -10}, 0},
+		{"sent before the indexing started", []int64{-5}, 0},
+		{"received after drained", []int64{10, -10, 3}, 3},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sm, ctx := newTestSubmodule(t)
+			for _, delta := range tc.deltas {
+				if err := sm.applyBalance(ctx, owner, collection, "1", math.NewInt(delta)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			balance, err := sm.balanceMap.Get(ctx, collections.Join3(owner, collection, "1"))
+			holder, holderErr := sm.holderMap.Get(ctx, collections.Join3(collection, "1", owner))
+			if tc.amount == 0 {
+				if err == nil || holderErr == nil {
+					t.Errorf("got balance %v and holder %v, want none", balance, holder)
+				}
+				return
+			}
+			if err != nil || holderErr != nil {
+				t.Fatalf("got errors %v and %v", err, holderErr)
+			}
+			want := fmt.Sprint(tc.amount)
+			if balance.Amount != want || holder.Amount != want {
+				t.Errorf("got balance %s and holder %s, want %s", balance.Amount, holder.Amount, want)
+			}
+		})
+	}
+}
+
+func TestTransferClearsTokenApproval(t *testing.T) {
+	contract, owner, spender, recipient := testAddress(1), testAddress(2), testAddress(3), testAddress(4)
+	zero := getCosmosAddress(common.Address{})
+
+	transfer := func(from, to sdk.AccAddress, tokenId uint64) types.EventWithAttributeMap {
+		return logEvent(t, contract, []string{types.TransferTopic, addressTopic(from), addressTopic(to), wordTopic(tokenId)}, "0x")
+	}
+
+	tests := []struct {
+		name  string
+		event types.EventWithAttributeMap
+		// cleared is whether the approval of token 1 is cleared
+		cleared bool
+	}{
+		{"transfer of the unindexed token", transfer(owner, recipient, 1), true},
+		{"burn of the unindexed token", transfer(owner, zero, 1), true},
+		{"transfer of another token", transfer(owner, recipient, 2), false},
+		{"transfer by another owner", transfer(recipient, owner, 1), false},
+		{"erc20 transfer", logEvent(t, contract, []string{types.TransferTopic, addressTopic(owner), addressTopic(recipient)}, wordTopic(1)), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sm, ctx := newTestSubmodule(t)
+			approval := types.ParsedApproval{
+				Type:     types.ApprovalTypeERC721Token,
+				Address:  common.BytesToAddress(contract),
+				Owner:    owner,
+				Spender:  spender,
+				TokenId:  "1",
+				Amount:   math.ZeroInt(),
+				Approved: true,
+			}
+			if err := sm.handleApprovalEvent(ctx, 1, &approval); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := sm.processEvents(ctx, 2, []types.EventWithAttributeMap{tc.event}); err != nil {
+				t.Fatal(err)
+			}
+
+			found, err := sm.approvalMap.Has(ctx, approvalKey(owner, contract, types.ApprovalTypeERC721Token, "1"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if found == tc.cleared {
+				t.Errorf("got approval found %v, want cleared %v", found, tc.cleared)
+			}
+		})
+	}
+}
+
+// count returns the number of the entries of the map
+func count[K, V any](t *testing.T, ctx context.Context, m *collections.Map[K, V]) int {
+	t.Helper()
+
+	n := 0
+	err := m.Walk(ctx, nil, func(K, V) (bool, error) {
+		n++
+		return false, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
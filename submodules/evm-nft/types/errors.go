@@ -3,5 +3,7 @@ package types
 import "github.com/pkg/errors"
 
 var (
-	ErrNotERC721 = errors.New("not erc721 transfer")
+	ErrNotERC721   = errors.New("not erc721 transfer")
+	ErrNotERC1155  = errors.New("not erc1155 transfer")
+	ErrNotApproval = errors.New("not approval")
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"math/big"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -31,8 +33,44 @@ type ParsedTransfer struct {
 	TokenId string
 }
 
+const (
+	// topics of the events in the ERC-20 and the ERC-721 standards
+	TransferTopic       = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	ApprovalTopic       = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+	ApprovalForAllTopic = "0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31"
+
+	// topics of the events in the ERC-1155 standard
+	TransferSingleTopic = "0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62"
+	TransferBatchTopic  = "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"
+)
+
 func (tl TransferLog) IsErc721Transfer() bool {
-	return (len(tl.Topics) == 4) && (tl.Topics[0] == "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef") && (tl.Data == "0x")
+	return (len(tl.Topics) == 4) && (tl.Topics[0] == TransferTopic) && (tl.Data == "0x")
+}
+
+// IsErc1155TransferSingle returns true if the log is the TransferSingle event of ERC-1155.
+func (tl TransferLog) IsErc1155TransferSingle() bool {
+	return (len(tl.Topics) == 4) && (tl.Topics[0] == TransferSingleTopic) && (len(strings.TrimPrefix(tl.Data, "0x")) == 128)
+}
+
+// IsErc1155TransferBatch returns true if the log is the TransferBatch event of ERC-1155.
+func (tl TransferLog) IsErc1155TransferBatch() bool {
+	return (len(tl.Topics) == 4) && (tl.Topics[0] == TransferBatchTopic)
+}
+
+// IsErc721Approval returns true if the log is the Approval event of ERC-721, whose token id is indexed.
+func (tl TransferLog) IsErc721Approval() bool {
+	return (len(tl.Topics) == 4) && (tl.Topics[0] == ApprovalTopic) && (tl.Data == "0x")
+}
+
+// IsErc20Approval returns true if the log is the Approval event of ERC-20, whose value is in the data.
+func (tl TransferLog) IsErc20Approval() bool {
+	return (len(tl.Topics) == 3) && (tl.Topics[0] == ApprovalTopic) && (len(strings.TrimPrefix(tl.Data, "0x")) == 64)
+}
+
+// IsApprovalForAll returns true if the log is the ApprovalForAll event, which is shared by ERC-721 and ERC-1155.
+func (tl TransferLog) IsApprovalForAll() bool {
+	return (len(tl.Topics) == 3) && (tl.Topics[0] == ApprovalForAllTopic) && (len(strings.TrimPrefix(tl.Data, "0x")) == 64)
 }
 
 func ParseERC721TransferLog(ac address.Codec, attributeValue string) (parsed *ParsedTransfer, err error) {
@@ -71,6 +109,192 @@ func ParseERC721TransferLog(ac address.Codec, attributeValue string) (parsed *Pa
 	}, nil
 }
 
+// ParsedMultiTransfer is the transfer of a single token id of an ERC-1155 collection
+type ParsedMultiTransfer struct {
+	Address common.Address
+	From    sdk.AccAddress
+	To      sdk.AccAddress
+	TokenId string
+	Amount  math.Int
+}
+
+// ParseERC1155TransferLog parses the TransferSingle or the TransferBatch event of ERC-1155.
+// the batch is split into the transfers of each token id.
+func ParseERC1155TransferLog(ac address.Codec, attributeValue string) (parsed []ParsedMultiTransfer, err error) {
+	tl := TransferLog{}
+	err = json.Unmarshal([]byte(attributeValue), &tl)
+	if err != nil {
+		return nil, errors.New("the attribute is not about log")
+	}
+	if !tl.IsErc1155TransferSingle() && !tl.IsErc1155TransferBatch() {
+		return nil, ErrNotERC1155
+	}
+
+	addr, err := evmtypes.ContractAddressFromString(ac, tl.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid contract address")
+	}
+
+	// topics[1] is the operator, which is not tracked
+	from, err := sdk.AccAddressFromHexUnsafe(strings.TrimPrefix(strings.TrimPrefix(tl.Topics[2], "0x"), "000000000000000000000000"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid from address")
+	}
+	to, err := sdk.AccAddressFromHexUnsafe(strings.TrimPrefix(strings.TrimPrefix(tl.Topics[3], "0x"), "000000000000000000000000"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid to address")
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(tl.Data, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid data")
+	}
+
+	var ids, values []*big.Int
+	if tl.IsErc1155TransferSingle() {
+		if len(data) < 64 {
+			return nil, errors.New("invalid data length")
+		}
+		ids = []*big.Int{new(big.Int).SetBytes(data[:32])}
+		values = []*big.Int{new(big.Int).SetBytes(data[32:64])}
+	} else {
+		if ids, err = readUint256Array(data, 0); err != nil {
+			return nil, errors.Wrap(err, "invalid token ids")
+		}
+		if values, err = readUint256Array(data, 32); err != nil {
+			return nil, errors.Wrap(err, "invalid values")
+		}
+		if len(ids) != len(values) {
+			return nil, errors.New("mismatched lengths of token ids and values")
+		}
+	}
+
+	for i, id := range ids {
+		parsed = append(parsed, ParsedMultiTransfer{
+			Address: addr,
+			From:    from,
+			To:      to,
+			TokenId: id.String(),
+			Amount:  math.NewIntFromBigInt(values[i]),
+		})
+	}
+
+	return parsed, nil
+}
+
+// readUint256Array reads the ABI encoded uint256[] whose offset is stored at the head
+func readUint256Array(data []byte, head uint64) ([]*big.Int, error) {
+	offset, err := readWord(data, head)
+	if err != nil {
+		return nil, err
+	}
+	length, err := readWord(data, offset)
+	if err != nil {
+		return nil, err
+	}
+	if length > uint64(len(data))/32 {
+		return nil, errors.New("invalid array length")
+	}
+
+	values := make([]*big.Int, length)
+	for i := range values {
+		start := offset + 32*uint64(i+1)
+		if start+32 > uint64(len(data)) {
+			return nil, errors.New("array out of range")
+		}
+		values[i] = new(big.Int).SetBytes(data[start : start+32])
+	}
+	return values, nil
+}
+
+// readWord reads the 32-byte word at the position as uint64
+func readWord(data []byte, pos uint64) (uint64, error) {
+	if pos > uint64(len(data)) || pos+32 > uint64(len(data)) {
+		return 0, errors.New("word out of range")
+	}
+	word := new(big.Int).SetBytes(data[pos : pos+32])
+	if !word.IsUint64() {
+		return 0, errors.New("word overflows uint64")
+	}
+	return word.Uint64(), nil
+}
+
+// ParsedApproval is the approval granted or revoked by the Approval or the ApprovalForAll event
+type ParsedApproval struct {
+	Type    ApprovalType
+	Address common.Address
+	Owner   sdk.AccAddress
+	Spender sdk.AccAddress
+	// TokenId is set for ApprovalTypeERC721Token only
+	TokenId string
+	// Amount is set for ApprovalTypeERC20Allowance only
+	Amount math.Int
+	// Approved is false if the approval is revoked
+	Approved bool
+}
+
+func ParseApprovalLog(ac address.Codec, attributeValue string) (parsed *ParsedApproval, err error) {
+	tl := TransferLog{}
+	err = json.Unmarshal([]byte(attributeValue), &tl)
+	if err != nil {
+		return nil, errors.New("the attribute is not about log")
+	}
+
+	parsed = &ParsedApproval{Amount: math.ZeroInt()}
+	switch {
+	case tl.IsErc721Approval():
+		parsed.Type = ApprovalTypeERC721Token
+	case tl.IsErc20Approval():
+		parsed.Type = ApprovalTypeERC20Allowance
+	case tl.IsApprovalForAll():
+		parsed.Type = ApprovalTypeOperator
+	default:
+		return nil, ErrNotApproval
+	}
+
+	parsed.Address, err = evmtypes.ContractAddressFromString(ac, tl.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid contract address")
+	}
+
+	parsed.Owner, err = sdk.AccAddressFromHexUnsafe(strings.TrimPrefix(strings.TrimPrefix(tl.Topics[1], "0x"), "000000000000000000000000"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid owner address")
+	}
+	parsed.Spender, err = sdk.AccAddressFromHexUnsafe(strings.TrimPrefix(strings.TrimPrefix(tl.Topics[2], "0x"), "000000000000000000000000"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid spender address")
+	}
+
+	switch parsed.Type {
+	case ApprovalTypeERC721Token:
+		parsed.TokenId, err = convertHexStringToDecString(tl.Topics[3])
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid token id")
+		}
+		// approving the zero address clears the approval
+		parsed.Approved = !parsed.Spender.Equals(sdk.AccAddress(common.Address{}.Bytes()))
+	case ApprovalTypeERC20Allowance:
+		amount, err := convertHexStringToDecString(tl.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid amount")
+		}
+		var ok bool
+		if parsed.Amount, ok = math.NewIntFromString(amount); !ok {
+			return nil, errors.New("invalid amount")
+		}
+		parsed.Approved = parsed.Amount.IsPositive()
+	case ApprovalTypeOperator:
+		approved, err := convertHexStringToDecString(tl.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid approved flag")
+		}
+		parsed.Approved = approved != "0"
+	}
+
+	return parsed, nil
+}
+
 func (pt ParsedTransfer) GetAction() NftAction {
 	emptyAddr, _ := sdk.AccAddressFromHexUnsafe("0000000000000000000000000000000000000000")
 	if pt.From.Equals(emptyAddr) && !pt.To.Equals(emptyAddr) {
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	testContract = "0x0000000000000000000000000000000000000001"
+	testOperator = "0x0000000000000000000000000000000000000000000000000000000000000009"
+	testFrom     = "0x0000000000000000000000000000000000000000000000000000000000000002"
+	testTo       = "0x0000000000000000000000000000000000000000000000000000000000000003"
+	testZero     = "0x0000000000000000000000000000000000000000000000000000000000000000"
+)
+
+// word returns the hex of the 32-byte word of the value
+func word(v uint64) string {
+	return fmt.Sprintf("%064x", v)
+}
+
+// testLog returns the log as the JSON attribute value of the evm event
+func testLog(t *testing.T, topics []string, data string) string {
+	t.Helper()
+
+	bz, err := json.Marshal(TransferLog{Address: testContract, Topics: topics, Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(bz)
+}
+
+func testAddress(i byte) sdk.AccAddress {
+	return sdk.AccAddress(append(make([]byte, 19), i))
+}
+
+func TestParseERC1155TransferLog(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("init")
+	single := []string{TransferSingleTopic, testOperator, testFrom, testTo}
+	batch := []string{TransferBatchTopic, testOperator, testFrom, testTo}
+
+	// ids [1, 2] and values [10, 20] of the batch, each array following its offset
+	batchData := "0x" + word(64) + word(160) + word(2) + word(1) + word(2) + word(2) + word(10) + word(20)
+
+	type transfer struct {
+		tokenId string
+		amount  int64
+	}
+	tests := []struct {
+		name    string
+		log     string
+		want    []transfer
+		wantErr error
+	}{
+		{"transfer single", testLog(t, single, "0x"+word(7)+word(100)), []transfer{{"7", 100}}, nil},
+		{"transfer batch", testLog(t, batch, batchData), []transfer{{"1", 10}, {"2", 20}}, nil},
+		{"empty batch", testLog(t, batch, "0x"+word(64)+word(96)+word(0)+word(0)), nil, nil},
+		{"erc721 transfer", testLog(t, []string{TransferTopic, testFrom, testTo, testZero}, "0x"), nil, ErrNotERC1155},
+		{"transfer single of short data", testLog(t, single, "0x"+word(7)), nil, ErrNotERC1155},
+		{"non-hex data", testLog(t, batch, "0xzz"), nil, errAny},
+		{"batch offset out of range", testLog(t, batch, "0x"+word(1024)+word(160)), nil, errAny},
+		{"batch length out of range", testLog(t, batch, "0x"+word(64)+word(96)+word(100)+word(0)), nil, errAny},
+		{"mismatched batch lengths", testLog(t, batch, "0x"+word(64)+word(128)+word(1)+word(1)+word(0)), nil, errAny},
+		{"not a log", `"value"`, nil, errAny},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := ParseERC1155TransferLog(ac, tc.log)
+			if !matchesErr(err, tc.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if len(parsed) != len(tc.want) {
+				t.Fatalf("got %d transfers, want %d", len(parsed), len(tc.want))
+			}
+			for i, p := range parsed {
+				if p.Address != common.HexToAddress(testContract) || !p.From.Equals(testAddress(2)) || !p.To.Equals(testAddress(3)) {
+					t.Errorf("got %s from %s to %s", p.Address, p.From, p.To)
+				}
+				if p.TokenId != tc.want[i].tokenId || !p.Amount.Equal(math.NewInt(tc.want[i].amount)) {
+					t.Errorf("got %s of token %s, want %d of token %s", p.Amount, p.TokenId, tc.want[i].amount, tc.want[i].tokenId)
+				}
+			}
+		})
+	}
+}
+
+func TestParseApprovalLog(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("init")
+
+	tests := []struct {
+		name    string
+		log     string
+		want    ParsedApproval
+		wantErr error
+	}{
+		{
+			"erc721 approval",
+			testLog(t, []string{ApprovalTopic, testFrom, testTo, "0x" + word(255)}, "0x"),
+			ParsedApproval{Type: ApprovalTypeERC721Token, Owner: testAddress(2), Spender: testAddress(3), TokenId: "255", Amount: math.ZeroInt(), Approved: true},
+			nil,
+		},
+		{
+			"erc721 approval cleared",
+			testLog(t, []string{ApprovalTopic, testFrom, testZero, "0x" + word(255)}, "0x"),
+			ParsedApproval{Type: ApprovalTypeERC721Token, Owner: testAddress(2), Spender: sdk.AccAddress(common.Address{}.Bytes()), TokenId: "255", Amount: math.ZeroInt()},
+			nil,
+		},
+		{
+			"erc20 allowance",
+			testLog(t, []string{ApprovalTopic, testFrom, testTo}, "0x"+word(1000)),
+			ParsedApproval{Type: ApprovalTypeERC20Allowance, Owner: testAddress(2), Spender: testAddress(3), Amount: math.NewInt(1000), Approved: true},
+			nil,
+		},
+		{
+			"erc20 allowance revoked",
+			testLog(t, []string{ApprovalTopic, testFrom, testTo}, "0x"+word(0)),
+			ParsedApproval{Type: ApprovalTypeERC20Allowance, Owner: testAddress(2), Spender: testAddress(3), Amount: math.ZeroInt()},
+			nil,
+		},
+		{
+			"operator approved",
+			testLog(t, []string{ApprovalForAllTopic, testFrom, testTo}, "0x"+word(1)),
+			ParsedApproval{Type: ApprovalTypeOperator, Owner: testAddress(2), Spender: testAddress(3), Amount: math.ZeroInt(), Approved: true},
+			nil,
+		},
+		{
+			"operator revoked",
+			testLog(t, []string{ApprovalForAllTopic, testFrom, testTo}, "0x"+word(0)),
+			ParsedApproval{Type: ApprovalTypeOperator, Owner: testAddress(2), Spender: testAddress(3), Amount: math.ZeroInt()},
+			nil,
+		},
+		{"transfer", testLog(t, []string{TransferTopic, testFrom, testTo}, "0x"+word(1)), ParsedApproval{}, ErrNotApproval},
+		{"erc20 allowance of short data", testLog(t, []string{ApprovalTopic, testFrom, testTo}, "0x01"), ParsedApproval{}, ErrNotApproval},
+		{"invalid owner", testLog(t, []string{ApprovalTopic, "0xzz", testTo}, "0x"+word(1)), ParsedApproval{}, errAny},
+		{"not a log", `"value"`, ParsedApproval{}, errAny},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := ParseApprovalLog(ac, tc.log)
+			if !matchesErr(err, tc.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if parsed.Type != tc.want.Type || parsed.Address != common.HexToAddress(testContract) ||
+				!parsed.Owner.Equals(tc.want.Owner) || !parsed.Spender.Equals(tc.want.Spender) ||
+				parsed.TokenId != tc.want.TokenId || !parsed.Amount.Equal(tc.want.Amount) || parsed.Approved != tc.want.Approved {
+				t.Errorf("got %+v, want %+v", *parsed, tc.want)
+			}
+		})
+	}
+}
+
+// errAny is the expected error of the cases failing with any error
+var errAny = errors.New("any error")
+
+func matchesErr(err, want error) bool {
+	switch want {
+	case nil:
+		return err == nil
+	case errAny:
+		return err != nil
+	default:
+		return errors.Is(err, want)
+	}
+}
@@ -5,7 +5,7 @@ const (
 	SubmoduleName = "evm-nft"
 
 	// Version is the current version of the submodule
-	Version = "v0.1.10"
+	Version = "v0.1.12"
 )
 
 // store prefixes
@@ -17,5 +17,11 @@ const (
 	TokensPrefix      = 0x30
 	TokenOwnersPrefix = 0x40
 
+	ApprovalsPrefix = 0x50
+	OperatorsPrefix = 0x51
+
+	BalancesPrefix = 0x60
+	HoldersPrefix  = 0x61
+
 	MigrationPrefix = 0xff
 )
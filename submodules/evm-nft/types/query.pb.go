@@ -0,0 +1,1965 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: indexer/evmnft/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/initia-labs/kvindexer/nft/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryApprovalsByAccountRequest is the request type for the
+// Query/ApprovalsByAccount RPC method
+type QueryApprovalsByAccountRequest struct {
+	// account is the bech32 or hex address of the owner
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// contract filters the approvals by the token contract if set
+	Contract   string             `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryApprovalsByAccountRequest) Reset()         { *m = QueryApprovalsByAccountRequest{} }
+func (m *QueryApprovalsByAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovalsByAccountRequest) ProtoMessage()    {}
+func (*QueryApprovalsByAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33a3a0b4d6e60cb, []int{0}
+}
+func (m *QueryApprovalsByAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovalsByAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovalsByAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryApprovalsByAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovalsByAccountRequest.Merge(m, src)
+}
+func (m *QueryApprovalsByAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovalsByAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovalsByAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryApprovalsByAccountRequest proto.InternalMessageInfo
+
+func (m *QueryApprovalsByAccountRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryApprovalsByAccountRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryApprovalsByAccountRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOperatorsByCollectionRequest is the request type for the
+// Query/OperatorsByCollection RPC method
+type QueryOperatorsByCollectionRequest struct {
+	// collection is the bech32 or hex address of the collection
+	Collection string             `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOperatorsByCollectionRequest) Reset()         { *m = QueryOperatorsByCollectionRequest{} }
+func (m *QueryOperatorsByCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorsByCollectionRequest) ProtoMessage()    {}
+func (*QueryOperatorsByCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33a3a0b4d6e60cb, []int{1}
+}
+func (m *QueryOperatorsByCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorsByCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorsByCollectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorsByCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorsByCollectionRequest.Merge(m, src)
+}
+func (m *QueryOperatorsByCollectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorsByCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorsByCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorsByCollectionRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorsByCollectionRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *QueryOperatorsByCollectionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryApprovalsResponse is the response type for the
+// Query/ApprovalsByAccount and Query/OperatorsByCollection RPC methods
+type QueryApprovalsResponse struct {
+	Approvals  []Approval          `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryApprovalsResponse) Reset()         { *m = QueryApprovalsResponse{} }
+func (m *QueryApprovalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryApprovalsResponse) ProtoMessage()    {}
+func (*QueryApprovalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33a3a0b4d6e60cb, []int{2}
+}
+func (m *QueryApprovalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryApprovalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryApprovalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryApprovalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryApprovalsResponse.Merge(m, src)
+}
+func (m *QueryApprovalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryApprovalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryApprovalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryApprovalsResponse proto.InternalMessageInfo
+
+func (m *QueryApprovalsResponse) GetApprovals() []Approval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *QueryApprovalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTokenBalancesByAccountRequest is the request type for the
+// Query/TokenBalancesByAccount RPC method
+type QueryTokenBalancesByAccountRequest struct {
+	// account is the bech32 or hex address of the holder
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// collection_addr filters the balances by the collection if set
+	CollectionAddr string             `protobuf:"bytes,2,opt,name=collection_addr,json=collectionAddr,proto3" json:"collection_addr,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTokenBalancesByAccountRequest) Reset()         { *m = QueryTokenBalancesByAccountRequest{} }
+func (m *QueryTokenBalancesByAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenBalancesByAccountRequest) ProtoMessage()    {}
+func (*QueryTokenBalancesByAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33a3a0b4d6e60cb, []int{3}
+}
+func (m *QueryTokenBalancesByAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenBalancesByAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenBalancesByAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenBalancesByAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenBalancesByAccountRequest.Merge(m, src)
+}
+func (m *QueryTokenBalancesByAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenBalancesByAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenBalancesByAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenBalancesByAccountRequest proto.InternalMessageInfo
+
+func (m *QueryTokenBalancesByAccountRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryTokenBalancesByAccountRequest) GetCollectionAddr() string {
+	if m != nil {
+		return m.CollectionAddr
+	}
+	return ""
+}
+
+func (m *QueryTokenBalancesByAccountRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTokenBalancesByTokenRequest is the request type for the
+// Query/TokenBalancesByToken RPC method
+type QueryTokenBalancesByTokenRequest struct {
+	// collection_addr is the bech32 or hex address of the collection
+	CollectionAddr string             `protobuf:"bytes,1,opt,name=collection_addr,json=collectionAddr,proto3" json:"collection_addr,omitempty"`
+	TokenId        string             `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTokenBalancesByTokenRequest) Reset()         { *m = QueryTokenBalancesByTokenRequest{} }
+func (m *QueryTokenBalancesByTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenBalancesByTokenRequest) ProtoMessage()    {}
+func (*QueryTokenBalancesByTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33a3a0b4d6e60cb, []int{4}
+}
+func (m *QueryTokenBalancesByTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenBalancesByTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenBalancesByTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenBalancesByTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenBalancesByTokenRequest.Merge(m, src)
+}
+func (m *QueryTokenBalancesByTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenBalancesByTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenBalancesByTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenBalancesByTokenRequest proto.InternalMessageInfo
+
+func (m *QueryTokenBalancesByTokenRequest) GetCollectionAddr() string {
+	if m != nil {
+		return m.CollectionAddr
+	}
+	return ""
+}
+
+func (m *QueryTokenBalancesByTokenRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *QueryTokenBalancesByTokenRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTokenBalancesResponse is the response type for the
+// Query/TokenBalancesByAccount and Query/TokenBalancesByToken RPC methods.
+// The amount of each token is the balance of its owner.
+type QueryTokenBalancesResponse struct {
+	Tokens     []*types.IndexedToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTokenBalancesResponse) Reset()         { *m = QueryTokenBalancesResponse{} }
+func (m *QueryTokenBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenBalancesResponse) ProtoMessage()    {}
+func (*QueryTokenBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a33a3a0b4d6e60cb, []int{5}
+}
+func (m *QueryTokenBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenBalancesResponse.Merge(m, src)
+}
+func (m *QueryTokenBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryTokenBalancesResponse) GetTokens() []*types.IndexedToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *QueryTokenBalancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryApprovalsByAccountRequest)(nil), "indexer.evmnft.v1.QueryApprovalsByAccountRequest")
+	proto.RegisterType((*QueryOperatorsByCollectionRequest)(nil), "indexer.evmnft.v1.QueryOperatorsByCollectionRequest")
+	proto.RegisterType((*QueryApprovalsResponse)(nil), "indexer.evmnft.v1.QueryApprovalsResponse")
+	proto.RegisterType((*QueryTokenBalancesByAccountRequest)(nil), "indexer.evmnft.v1.QueryTokenBalancesByAccountRequest")
+	proto.RegisterType((*QueryTokenBalancesByTokenRequest)(nil), "indexer.evmnft.v1.QueryTokenBalancesByTokenRequest")
+	proto.RegisterType((*QueryTokenBalancesResponse)(nil), "indexer.evmnft.v1.QueryTokenBalancesResponse")
+}
+
+func init() { proto.RegisterFile("indexer/evmnft/v1/query.proto", fileDescriptor_a33a3a0b4d6e60cb) }
+
+var fileDescriptor_a33a3a0b4d6e60cb = []byte{
+	// 683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x2d, 0xf4, 0xc7, 0x55, 0x02, 0x71, 0x2a, 0x55, 0x30, 0xc5, 0x84, 0x0c, 0xb4,
+	0x20, 0xd5, 0xa7, 0xb4, 0xe5, 0x47, 0x17, 0xaa, 0x06, 0x89, 0xaa, 0x53, 0x21, 0x30, 0xb1, 0x54,
+	0x67, 0xfb, 0x30, 0x56, 0x1d, 0x9f, 0xeb, 0xbb, 0x44, 0x44, 0x51, 0x96, 0xae, 0x2c, 0x48, 0x8c,
+	0x48, 0x0c, 0xec, 0xc0, 0xcc, 0xc2, 0x5c, 0x89, 0xa5, 0x12, 0x0b, 0x13, 0x42, 0x2d, 0x7f, 0x08,
+	0xf2, 0xf9, 0x2e, 0x69, 0x9b, 0xeb, 0x2f, 0x94, 0xed, 0xde, 0x7b, 0x7e, 0x7a, 0x9f, 0xf7, 0xee,
+	0xfb, 0xce, 0xf0, 0x46, 0x18, 0xfb, 0xf4, 0x0d, 0x4d, 0x31, 0x6d, 0xd6, 0xe3, 0x57, 0x02, 0x37,
+	0x2b, 0x78, 0xab, 0x41, 0xd3, 0x96, 0x93, 0xa4, 0x4c, 0x30, 0x74, 0x45, 0x85, 0x9d, 0x3c, 0xec,
+	0x34, 0x2b, 0xd6, 0x5d, 0x8f, 0xf1, 0x3a, 0xe3, 0xd8, 0x25, 0x9c, 0xe6, 0xdf, 0xe2, 0x66, 0xc5,
+	0xa5, 0x82, 0x54, 0x70, 0x42, 0x82, 0x30, 0x26, 0x22, 0x64, 0x71, 0x9e, 0x6e, 0x4d, 0x06, 0x2c,
+	0x60, 0xf2, 0x88, 0xb3, 0x93, 0xf2, 0x4e, 0x07, 0x8c, 0x05, 0x11, 0xc5, 0x24, 0x09, 0x31, 0x89,
+	0x63, 0x26, 0x64, 0x0a, 0x57, 0x51, 0x03, 0x91, 0x68, 0x25, 0x54, 0x87, 0x2d, 0x1d, 0xee, 0x8f,
+	0x95, 0x3f, 0x02, 0x68, 0x3f, 0xcb, 0x88, 0x56, 0x92, 0x24, 0x65, 0x4d, 0x12, 0xf1, 0x6a, 0x6b,
+	0xc5, 0xf3, 0x58, 0x23, 0x16, 0x35, 0xba, 0xd5, 0xa0, 0x5c, 0xa0, 0x22, 0x1c, 0x25, 0xb9, 0xa7,
+	0x08, 0x4a, 0x60, 0x76, 0xbc, 0xa6, 0x4d, 0x64, 0xc1, 0x31, 0x8f, 0xc5, 0x22, 0x25, 0x9e, 0x28,
+	0x0e, 0xc9, 0x50, 0xd7, 0x46, 0x4f, 0x20, 0xec, 0xf5, 0x56, 0x1c, 0x2e, 0x81, 0xd9, 0x89, 0xf9,
+	0xdb, 0x4e, 0x3e, 0x08, 0x27, 0x1b, 0x84, 0x93, 0x0f, 0x4d, 0x0d, 0xc2, 0x79, 0x4a, 0x02, 0xaa,
+	0x2a, 0xd6, 0x0e, 0x64, 0x96, 0xdf, 0x02, 0x78, 0x4b, 0x02, 0xae, 0x27, 0x34, 0x25, 0x82, 0xa5,
+	0xbc, 0xda, 0x7a, 0xcc, 0xa2, 0x88, 0x7a, 0x59, 0x58, 0x33, 0xda, 0x10, 0x7a, 0x5d, 0xa7, 0xc2,
+	0x3c, 0xe0, 0x39, 0x42, 0x33, 0xf4, 0xdf, 0x34, 0x9f, 0x00, 0x9c, 0x3a, 0x3c, 0xae, 0x1a, 0xe5,
+	0x09, 0x8b, 0x39, 0x45, 0xcb, 0x70, 0x9c, 0x68, 0x67, 0x11, 0x94, 0x86, 0x67, 0x27, 0xe6, 0xaf,
+	0x3b, 0x7d, 0x5a, 0x70, 0x74, 0x62, 0xf5, 0xc2, 0xce, 0xef, 0x9b, 0x85, 0x5a, 0x2f, 0x07, 0xad,
+	0x1a, 0x18, 0x67, 0x4e, 0x65, 0xcc, 0xab, 0x1f, 0x82, 0xfc, 0x0a, 0x60, 0x59, 0x42, 0xbe, 0x60,
+	0x9b, 0x34, 0xae, 0x92, 0x88, 0xc4, 0x1e, 0x3d, 0xcf, 0xbd, 0xce, 0xc0, 0xcb, 0xbd, 0xd9, 0x6d,
+	0x10, 0xdf, 0x4f, 0xd5, 0xf5, 0x5e, 0xea, 0xb9, 0x57, 0x7c, 0x3f, 0x1d, 0xd8, 0x25, 0x7f, 0x01,
+	0xb0, 0x64, 0x22, 0x96, 0xa6, 0xe6, 0x35, 0x50, 0x01, 0x23, 0xd5, 0x35, 0x38, 0x26, 0xb2, 0xc4,
+	0x8d, 0xd0, 0x57, 0xdc, 0xa3, 0xd2, 0x5e, 0xf3, 0x07, 0x06, 0xfc, 0x01, 0x40, 0xab, 0x1f, 0xb8,
+	0xab, 0x85, 0x45, 0x38, 0x22, 0x2b, 0x6a, 0x21, 0x4c, 0x77, 0x85, 0xa0, 0x54, 0xb0, 0x26, 0x4d,
+	0x3f, 0xef, 0x4f, 0x7d, 0x3b, 0x30, 0x01, 0xcc, 0x6f, 0x8f, 0xc0, 0x8b, 0x92, 0x0e, 0x7d, 0x06,
+	0x10, 0xf5, 0x6f, 0x36, 0xaa, 0x18, 0x84, 0x79, 0xf2, 0x2b, 0x60, 0xdd, 0x39, 0x35, 0x45, 0xa3,
+	0x94, 0x97, 0xb6, 0x7f, 0xfe, 0x7d, 0x3f, 0xb4, 0x80, 0x2a, 0xb8, 0xff, 0x5d, 0xea, 0xca, 0x1d,
+	0xbb, 0xad, 0x0d, 0xa5, 0x37, 0xdc, 0x56, 0x87, 0x0e, 0xfa, 0x06, 0xe0, 0x55, 0xe3, 0xa2, 0xa3,
+	0xc5, 0xe3, 0xea, 0x9f, 0xf4, 0x2e, 0x9c, 0x87, 0x7a, 0x59, 0x52, 0x2f, 0xa1, 0x07, 0x06, 0x6a,
+	0xa6, 0x6b, 0x64, 0xd4, 0x3d, 0xb5, 0xe1, 0x76, 0xef, 0xdc, 0x41, 0xdf, 0x01, 0x9c, 0x32, 0x6f,
+	0x1c, 0xba, 0x77, 0x1c, 0xc6, 0x89, 0x1b, 0x6a, 0xcd, 0x9d, 0x29, 0xad, 0xdb, 0xc1, 0x23, 0xd9,
+	0xc1, 0x43, 0x74, 0xdf, 0xd0, 0x41, 0xbe, 0x10, 0xae, 0x4a, 0x31, 0x0f, 0xff, 0x07, 0x80, 0x93,
+	0xa6, 0x05, 0x44, 0x0b, 0x67, 0xc4, 0x3f, 0xb8, 0xae, 0xe7, 0x85, 0x5f, 0x97, 0xf0, 0x6b, 0x68,
+	0xf5, 0x4c, 0xf0, 0xd2, 0x83, 0xdb, 0x47, 0xde, 0x83, 0x0e, 0x6e, 0xeb, 0xc5, 0xef, 0x54, 0x9f,
+	0xef, 0xec, 0xd9, 0x60, 0x77, 0xcf, 0x06, 0x7f, 0xf6, 0x6c, 0xf0, 0x6e, 0xdf, 0x2e, 0xec, 0xee,
+	0xdb, 0x85, 0x5f, 0xfb, 0x76, 0xe1, 0xe5, 0x52, 0x10, 0x8a, 0xd7, 0x0d, 0xd7, 0xf1, 0x58, 0x1d,
+	0x87, 0x71, 0x28, 0x42, 0x32, 0x17, 0x11, 0x97, 0xe3, 0xcd, 0xa6, 0x2e, 0xcd, 0x1b, 0x6e, 0x9d,
+	0xf9, 0x8d, 0x88, 0xf2, 0x8c, 0x62, 0x2e, 0xc3, 0x90, 0x3f, 0x4d, 0x77, 0x44, 0xfe, 0x35, 0x17,
+	0xfe, 0x0d, 0x00, 0xff, 0x00, 0xed, 0xfd, 0x04, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ApprovalsByAccount queries the approvals currently granted by given
+	// account
+	ApprovalsByAccount(ctx context.Context, in *QueryApprovalsByAccountRequest, opts ...grpc.CallOption) (*QueryApprovalsResponse, error)
+	// OperatorsByCollection queries the operators currently approved for all
+	// tokens of given collection
+	OperatorsByCollection(ctx context.Context, in *QueryOperatorsByCollectionRequest, opts ...grpc.CallOption) (*QueryApprovalsResponse, error)
+	// TokenBalancesByAccount queries the ERC-1155 token balances of given
+	// account
+	TokenBalancesByAccount(ctx context.Context, in *QueryTokenBalancesByAccountRequest, opts ...grpc.CallOption) (*QueryTokenBalancesResponse, error)
+	// TokenBalancesByToken queries the balances of the holders of given ERC-1155
+	// token
+	TokenBalancesByToken(ctx context.Context, in *QueryTokenBalancesByTokenRequest, opts ...grpc.CallOption) (*QueryTokenBalancesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ApprovalsByAccount(ctx context.Context, in *QueryApprovalsByAccountRequest, opts ...grpc.CallOption) (*QueryApprovalsResponse, error) {
+	out := new(QueryApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evmnft.v1.Query/ApprovalsByAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorsByCollection(ctx context.Context, in *QueryOperatorsByCollectionRequest, opts ...grpc.CallOption) (*QueryApprovalsResponse, error) {
+	out := new(QueryApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evmnft.v1.Query/OperatorsByCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenBalancesByAccount(ctx context.Context, in *QueryTokenBalancesByAccountRequest, opts ...grpc.CallOption) (*QueryTokenBalancesResponse, error) {
+	out := new(QueryTokenBalancesResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evmnft.v1.Query/TokenBalancesByAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenBalancesByToken(ctx context.Context, in *QueryTokenBalancesByTokenRequest, opts ...grpc.CallOption) (*QueryTokenBalancesResponse, error) {
+	out := new(QueryTokenBalancesResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evmnft.v1.Query/TokenBalancesByToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ApprovalsByAccount queries the approvals currently granted by given
+	// account
+	ApprovalsByAccount(context.Context, *QueryApprovalsByAccountRequest) (*QueryApprovalsResponse, error)
+	// OperatorsByCollection queries the operators currently approved for all
+	// tokens of given collection
+	OperatorsByCollection(context.Context, *QueryOperatorsByCollectionRequest) (*QueryApprovalsResponse, error)
+	// TokenBalancesByAccount queries the ERC-1155 token balances of given
+	// account
+	TokenBalancesByAccount(context.Context, *QueryTokenBalancesByAccountRequest) (*QueryTokenBalancesResponse, error)
+	// TokenBalancesByToken queries the balances of the holders of given ERC-1155
+	// token
+	TokenBalancesByToken(context.Context, *QueryTokenBalancesByTokenRequest) (*QueryTokenBalancesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ApprovalsByAccount(ctx context.Context, req *QueryApprovalsByAccountRequest) (*QueryApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovalsByAccount not implemented")
+}
+func (*UnimplementedQueryServer) OperatorsByCollection(ctx context.Context, req *QueryOperatorsByCollectionRequest) (*QueryApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorsByCollection not implemented")
+}
+func (*UnimplementedQueryServer) TokenBalancesByAccount(ctx context.Context, req *QueryTokenBalancesByAccountRequest) (*QueryTokenBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenBalancesByAccount not implemented")
+}
+func (*UnimplementedQueryServer) TokenBalancesByToken(ctx context.Context, req *QueryTokenBalancesByTokenRequest) (*QueryTokenBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenBalancesByToken not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ApprovalsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApprovalsByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ApprovalsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evmnft.v1.Query/ApprovalsByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ApprovalsByAccount(ctx, req.(*QueryApprovalsByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorsByCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorsByCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorsByCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evmnft.v1.Query/OperatorsByCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorsByCollection(ctx, req.(*QueryOperatorsByCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenBalancesByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenBalancesByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenBalancesByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evmnft.v1.Query/TokenBalancesByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenBalancesByAccount(ctx, req.(*QueryTokenBalancesByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenBalancesByToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenBalancesByTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenBalancesByToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evmnft.v1.Query/TokenBalancesByToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenBalancesByToken(ctx, req.(*QueryTokenBalancesByTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "indexer.evmnft.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApprovalsByAccount",
+			Handler:    _Query_ApprovalsByAccount_Handler,
+		},
+		{
+			MethodName: "OperatorsByCollection",
+			Handler:    _Query_OperatorsByCollection_Handler,
+		},
+		{
+			MethodName: "TokenBalancesByAccount",
+			Handler:    _Query_TokenBalancesByAccount_Handler,
+		},
+		{
+			MethodName: "TokenBalancesByToken",
+			Handler:    _Query_TokenBalancesByToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "indexer/evmnft/v1/query.proto",
+}
+
+func (m *QueryApprovalsByAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovalsByAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovalsByAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorsByCollectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorsByCollectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorsByCollectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApprovalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApprovalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApprovalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenBalancesByAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenBalancesByAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenBalancesByAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CollectionAddr) > 0 {
+		i -= len(m.CollectionAddr)
+		copy(dAtA[i:], m.CollectionAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollectionAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenBalancesByTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenBalancesByTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenBalancesByTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollectionAddr) > 0 {
+		i -= len(m.CollectionAddr)
+		copy(dAtA[i:], m.CollectionAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollectionAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryApprovalsByAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorsByCollectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryApprovalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenBalancesByAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CollectionAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenBalancesByTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollectionAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryApprovalsByAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApprovalsByAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApprovalsByAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorsByCollectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorsByCollectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorsByCollectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryApprovalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApprovalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApprovalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, Approval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenBalancesByAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenBalancesByAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenBalancesByAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenBalancesByTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenBalancesByTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenBalancesByTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &types.IndexedToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: indexer/evmnft/v1/types.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ApprovalType defines the kind of the approval
+type ApprovalType int32
+
+const (
+	ApprovalTypeUnspecified ApprovalType = 0
+	// ERC-20 allowance granted by the Approval log
+	ApprovalTypeERC20Allowance ApprovalType = 1
+	// ERC-721 approval of a single token granted by the Approval log
+	ApprovalTypeERC721Token ApprovalType = 2
+	// approval of all tokens of the collection granted by the ApprovalForAll
+	// log, which is shared by ERC-721 and ERC-1155
+	ApprovalTypeOperator ApprovalType = 3
+)
+
+var ApprovalType_name = map[int32]string{
+	0: "APPROVAL_TYPE_UNSPECIFIED",
+	1: "APPROVAL_TYPE_ERC20_ALLOWANCE",
+	2: "APPROVAL_TYPE_ERC721_TOKEN",
+	3: "APPROVAL_TYPE_OPERATOR",
+}
+
+var ApprovalType_value = map[string]int32{
+	"APPROVAL_TYPE_UNSPECIFIED":     0,
+	"APPROVAL_TYPE_ERC20_ALLOWANCE": 1,
+	"APPROVAL_TYPE_ERC721_TOKEN":    2,
+	"APPROVAL_TYPE_OPERATOR":        3,
+}
+
+func (x ApprovalType) String() string {
+	return proto.EnumName(ApprovalType_name, int32(x))
+}
+
+func (ApprovalType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_28c9c7359816a8a5, []int{0}
+}
+
+// Approval defines an approval currently granted by an account
+type Approval struct {
+	Type ApprovalType `protobuf:"varint,1,opt,name=type,proto3,enum=indexer.evmnft.v1.ApprovalType" json:"type,omitempty"`
+	// contract is the bech32 address of the token contract or the collection
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// owner is the bech32 address of the account granted the approval
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// spender is the bech32 address of the approved spender or operator
+	Spender string `protobuf:"bytes,4,opt,name=spender,proto3" json:"spender,omitempty"`
+	// token_id is set for the ERC-721 token approval only
+	TokenId string `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// amount is the last approved amount of the ERC-20 allowance. It is not
+	// decreased by the transfers as no log is emitted for them.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// height is the height of the block in which the approval was granted
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *Approval) Reset()         { *m = Approval{} }
+func (m *Approval) String() string { return proto.CompactTextString(m) }
+func (*Approval) ProtoMessage()    {}
+func (*Approval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28c9c7359816a8a5, []int{0}
+}
+func (m *Approval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Approval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Approval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Approval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Approval.Merge(m, src)
+}
+func (m *Approval) XXX_Size() int {
+	return m.Size()
+}
+func (m *Approval) XXX_DiscardUnknown() {
+	xxx_messageInfo_Approval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Approval proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("indexer.evmnft.v1.ApprovalType", ApprovalType_name, ApprovalType_value)
+	proto.RegisterType((*Approval)(nil), "indexer.evmnft.v1.Approval")
+}
+
+func init() { proto.RegisterFile("indexer/evmnft/v1/types.proto", fileDescriptor_28c9c7359816a8a5) }
+
+var fileDescriptor_28c9c7359816a8a5 = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x41, 0x8f, 0xd2, 0x40,
+	0x00, 0x85, 0x3b, 0x0b, 0xcb, 0xe2, 0xc4, 0x18, 0x9c, 0xe0, 0xda, 0xad, 0x61, 0x68, 0x3c, 0x11,
+	0x93, 0x6d, 0x85, 0xd5, 0x18, 0xf5, 0xd4, 0xc5, 0x9a, 0x10, 0x09, 0x25, 0x95, 0xd5, 0xa8, 0x07,
+	0x52, 0xda, 0x59, 0x98, 0x40, 0x67, 0x9a, 0x76, 0xe8, 0xba, 0x7f, 0xc0, 0x18, 0x4e, 0xfe, 0x01,
+	0x4e, 0x5e, 0xfc, 0x29, 0x1c, 0xf7, 0x68, 0x3c, 0x6c, 0x14, 0x7e, 0x83, 0x77, 0xd3, 0x16, 0x0c,
+	0x1b, 0x6e, 0x7d, 0xfd, 0xde, 0x7b, 0x93, 0x99, 0x3c, 0x58, 0xa1, 0xcc, 0x23, 0x9f, 0x49, 0xa8,
+	0x93, 0xd8, 0x67, 0xe7, 0x42, 0x8f, 0xeb, 0xba, 0xb8, 0x0c, 0x48, 0xa4, 0x05, 0x21, 0x17, 0x1c,
+	0xdd, 0x5d, 0x63, 0x2d, 0xc3, 0x5a, 0x5c, 0x57, 0xca, 0x43, 0x3e, 0xe4, 0x29, 0xd5, 0x93, 0xaf,
+	0xcc, 0xf8, 0xf0, 0x2f, 0x80, 0x45, 0x23, 0x08, 0x42, 0x1e, 0x3b, 0x13, 0x74, 0x02, 0xf3, 0x49,
+	0x89, 0x0c, 0x54, 0x50, 0xbb, 0xd3, 0xa8, 0x6a, 0x3b, 0x25, 0xda, 0xc6, 0xda, 0xbb, 0x0c, 0x88,
+	0x9d, 0x9a, 0x91, 0x02, 0x8b, 0x2e, 0x67, 0x22, 0x74, 0x5c, 0x21, 0xef, 0xa9, 0xa0, 0x76, 0xcb,
+	0xfe, 0xaf, 0x51, 0x19, 0xee, 0xf3, 0x0b, 0x46, 0x42, 0x39, 0x97, 0x82, 0x4c, 0x20, 0x19, 0x1e,
+	0x44, 0x01, 0x61, 0x1e, 0x09, 0xe5, 0x7c, 0xfa, 0x7f, 0x23, 0xd1, 0x11, 0x2c, 0x0a, 0x3e, 0x26,
+	0xac, 0x4f, 0x3d, 0x79, 0x3f, 0x43, 0xa9, 0x6e, 0x79, 0xe8, 0x29, 0x2c, 0x38, 0x3e, 0x9f, 0x32,
+	0x21, 0x17, 0x12, 0x70, 0x5a, 0x59, 0x5c, 0x57, 0xa5, 0x5f, 0xd7, 0xd5, 0x7b, 0x2e, 0x8f, 0x7c,
+	0x1e, 0x45, 0xde, 0x58, 0xa3, 0x5c, 0xf7, 0x1d, 0x31, 0xd2, 0x5a, 0x4c, 0xd8, 0x6b, 0x33, 0x3a,
+	0x84, 0x85, 0x11, 0xa1, 0xc3, 0x91, 0x90, 0x0f, 0x54, 0x50, 0xcb, 0xd9, 0x6b, 0xf5, 0xe8, 0xcb,
+	0x1e, 0xbc, 0xbd, 0x7d, 0x19, 0xf4, 0x02, 0x1e, 0x19, 0xdd, 0xae, 0x6d, 0xbd, 0x33, 0xda, 0xfd,
+	0xde, 0x87, 0xae, 0xd9, 0x3f, 0xeb, 0xbc, 0xed, 0x9a, 0xcd, 0xd6, 0xeb, 0x96, 0xf9, 0xaa, 0x24,
+	0x29, 0x0f, 0x66, 0x73, 0xf5, 0xfe, 0x76, 0xe0, 0x8c, 0x45, 0x01, 0x71, 0xe9, 0x39, 0x25, 0x1e,
+	0x32, 0x60, 0xe5, 0x66, 0xd6, 0xb4, 0x9b, 0x8d, 0xc7, 0x7d, 0xa3, 0xdd, 0xb6, 0xde, 0x1b, 0x9d,
+	0xa6, 0x59, 0x02, 0x0a, 0x9e, 0xcd, 0x55, 0x65, 0x3b, 0x9f, 0x5a, 0x8c, 0xc9, 0x84, 0x5f, 0x38,
+	0xcc, 0x25, 0xe8, 0x25, 0x54, 0x76, 0x2a, 0x9e, 0x35, 0xea, 0xfd, 0x9e, 0xf5, 0xc6, 0xec, 0x94,
+	0xf6, 0x76, 0xcf, 0xcf, 0x78, 0x2f, 0x79, 0x1d, 0xf4, 0x04, 0x1e, 0xde, 0x0c, 0x5b, 0x5d, 0xd3,
+	0x36, 0x7a, 0x96, 0x5d, 0xca, 0x29, 0xf2, 0x6c, 0xae, 0x96, 0xb7, 0x83, 0x56, 0x40, 0x42, 0x47,
+	0xf0, 0x50, 0xc9, 0x7f, 0xfd, 0x8e, 0xa5, 0xd3, 0x4f, 0x8b, 0x3f, 0x58, 0xfa, 0xb1, 0xc4, 0x60,
+	0xb1, 0xc4, 0xe0, 0x6a, 0x89, 0xc1, 0xef, 0x25, 0x06, 0xdf, 0x56, 0x58, 0xba, 0x5a, 0x61, 0xe9,
+	0xe7, 0x0a, 0x4b, 0x1f, 0x9f, 0x0f, 0xa9, 0x18, 0x4d, 0x07, 0x9a, 0xcb, 0x7d, 0x9d, 0x32, 0x2a,
+	0xa8, 0x73, 0x3c, 0x71, 0x06, 0x91, 0x3e, 0x8e, 0x37, 0x1b, 0x8c, 0xa6, 0x03, 0x9f, 0x7b, 0xd3,
+	0x09, 0x89, 0x92, 0x39, 0x1e, 0x27, 0x7b, 0x4c, 0xc7, 0x38, 0x28, 0xa4, 0x23, 0x3b, 0xf9, 0x37,
+	0x00, 0x4c, 0x29, 0x28, 0x1b, 0xae, 0x02, 0x00, 0x00,
+}
+
+func (this *Approval) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Approval)
+	if !ok {
+		that2, ok := that.(Approval)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Spender != that1.Spender {
+		return false
+	}
+	if this.TokenId != that1.TokenId {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (m *Approval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Approval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Approval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Approval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Approval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Approval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Approval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ApprovalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
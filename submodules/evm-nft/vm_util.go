@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"github.com/pkg/errors"
 
@@ -15,6 +16,7 @@ import (
 
 	nfttypes "github.com/initia-labs/kvindexer/nft/types"
 	"github.com/initia-labs/kvindexer/submodules/evm-nft/types"
+	evmtypes "github.com/initia-labs/minievm/x/evm/types"
 )
 
 var eventTypes = []string{"evm"}
@@ -88,6 +90,42 @@ func (sm EvmNFTSubmodule) getIndexedNftFromVMStore(ctx context.Context, contract
 	return &indexed, nil
 }
 
+// getAuthoritativeToken returns the token and its owner from the VM store
+func (sm EvmNFTSubmodule) getAuthoritativeToken(ctx context.Context, key collections.Pair[sdk.AccAddress, string], _ nfttypes.IndexedToken) (*nfttypes.Token, sdk.AccAddress, error) {
+	classId, err := evmtypes.ClassIdFromCollectionAddress(ctx, sm.vmKeeper, common.BytesToAddress(key.K1()))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get classId from collection address")
+	}
+
+	resource, err := sm.getNftResourceFromVMStore(ctx, classId, key.K2())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownerAddr, err := sm.vmKeeper.ERC721Keeper().OwnerOf(ctx, key.K2(), classId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get token owner")
+	}
+
+	return &nfttypes.Token{TokenId: key.K2(), Uri: resource.TokenUri}, getCosmosAddress(ownerAddr), nil
+}
+
+// getAuthoritativeCollection returns the collection from the VM store.
+// nfts.length isn't tracked by the VM, so it's left empty to be filled with the number of indexed tokens.
+func (sm EvmNFTSubmodule) getAuthoritativeCollection(ctx context.Context, collectionAddr sdk.AccAddress) (*nfttypes.Collection, error) {
+	classId, err := evmtypes.ClassIdFromCollectionAddress(ctx, sm.vmKeeper, common.BytesToAddress(collectionAddr))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get classId from collection address")
+	}
+
+	resource, err := sm.getCollectionFromVMStore(ctx, classId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resource.Collection, nil
+}
+
 func getCosmosAddress(addr common.Address) sdk.AccAddress {
 	return sdk.AccAddress(addr.Bytes())
 }
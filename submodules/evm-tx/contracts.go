@@ -0,0 +1,146 @@
+package tx
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	cosmoserr "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/submodules/evm-tx/types/evm"
+	txindexer "github.com/initia-labs/kvindexer/tx"
+	evmtypes "github.com/initia-labs/minievm/x/evm/types"
+)
+
+// msgIndexAttributeKey is the attribute appended to the events of each message with its index
+const msgIndexAttributeKey = "msg_index"
+
+// deployedContract is the contract deployed or registered as an ERC token by the tx
+type deployedContract struct {
+	addr         sdk.AccAddress
+	contractType evm.ContractType
+	// deployer is nil if the deployer is unknown
+	deployer sdk.AccAddress
+}
+
+// grepContracts returns the contracts deployed or registered as ERC tokens by the tx in the emitted order.
+// The deployer is the sender of the message the contract is created in, who is the caller of the
+// evm for both the top-level and the factory creations. The signer is used only for the top-level
+// creation when the tx has a single signer and the message event is missing.
+func grepContracts(ac address.Codec, txr *sdk.TxResponse, signers []sdk.AccAddress) []deployedContract {
+	contracts := []deployedContract{}
+	found := map[string]int{}
+	senders := map[string]sdk.AccAddress{}
+	for _, event := range txr.Events {
+		msgIndex := eventAttribute(event, msgIndexAttributeKey)
+
+		var contractType evm.ContractType
+		switch event.Type {
+		case sdk.EventTypeMessage:
+			sender := eventAttribute(event, sdk.AttributeKeySender)
+			if sender == "" {
+				continue
+			}
+			if addr, err := txindexer.AccAddressFromString(ac, sender); err == nil {
+				senders[msgIndex] = addr
+			}
+			continue
+		case evmtypes.EventTypeCreate, evmtypes.EventTypeContractCreated:
+			contractType = evm.ContractTypeUnspecified
+		case evmtypes.EventTypeERC20Created:
+			contractType = evm.ContractTypeERC20
+		case evmtypes.EventTypeERC721Created:
+			contractType = evm.ContractTypeERC721
+		default:
+			continue
+		}
+
+		deployer := senders[msgIndex]
+		if deployer == nil && event.Type == evmtypes.EventTypeCreate && len(signers) == 1 {
+			deployer = signers[0]
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyContract {
+				continue
+			}
+
+			addr, err := evmAddressFromHex(attr.Value)
+			if err != nil {
+				continue
+			}
+
+			if i, ok := found[addr.String()]; ok {
+				if contractType != evm.ContractTypeUnspecified {
+					contracts[i].contractType = contractType
+				}
+				if contracts[i].deployer == nil {
+					contracts[i].deployer = deployer
+				}
+				continue
+			}
+			found[addr.String()] = len(contracts)
+			contracts = append(contracts, deployedContract{addr, contractType, deployer})
+		}
+	}
+	return contracts
+}
+
+// eventAttribute returns the value of the first attribute of given key in the event
+func eventAttribute(event abci.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// storeContracts stores the contracts deployed by the tx. The contracts are not pruned
+// as they are the registry of the contracts on the chain.
+func (sm EvmTxSubmodule) storeContracts(ctx context.Context, seq uint64, idx txindexer.IndexedTx) error {
+	contracts := grepContracts(sm.ac, idx.TxResponse, idx.Signers)
+	if len(contracts) == 0 {
+		return nil
+	}
+
+	for _, c := range contracts {
+		contract, err := sm.contractMap.Get(ctx, c.addr)
+		switch {
+		case err == nil:
+			// the contract registered as an ERC token after the deployment
+			if contract.Type != evm.ContractTypeUnspecified || c.contractType == evm.ContractTypeUnspecified {
+				continue
+			}
+			contract.Type = c.contractType
+		case cosmoserr.IsOf(err, collections.ErrNotFound):
+			contract = evm.Contract{
+				Address: hexAddress(c.addr),
+				TxHash:  idx.TxResponse.TxHash,
+				Height:  idx.TxResponse.Height,
+				Type:    c.contractType,
+			}
+			if c.deployer != nil {
+				contract.Deployer = hexAddress(c.deployer)
+				if err = sm.contractsByDeployerMap.Set(ctx, collections.Join3(c.deployer, seq, c.addr), true); err != nil {
+					return err
+				}
+			}
+		default:
+			return err
+		}
+
+		if err = sm.contractMap.Set(ctx, c.addr, contract); err != nil {
+			return err
+		}
+		if contract.Type != evm.ContractTypeUnspecified {
+			if err = sm.contractsByTypeMap.Set(ctx, collections.Join3(int32(contract.Type), seq, c.addr), true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
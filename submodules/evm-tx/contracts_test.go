@@ -0,0 +1,102 @@
+package tx
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/submodules/evm-tx/types/evm"
+	evmtypes "github.com/initia-labs/minievm/x/evm/types"
+)
+
+func TestGrepContractsDeployer(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("init")
+	address := func(b byte) sdk.AccAddress { return sdk.AccAddress(append(make([]byte, 19), b)) }
+	bech32 := func(addr sdk.AccAddress) string {
+		s, err := ac.BytesToString(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	event := func(eventType string, attrs ...string) abci.Event {
+		event := abci.Event{Type: eventType}
+		for i := 0; i+1 < len(attrs); i += 2 {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+		}
+		return event
+	}
+
+	sender, feePayer, factory := address(1), address(2), address(3)
+	contract, child := address(10), address(11)
+
+	tests := []struct {
+		name    string
+		events  []abci.Event
+		signers []sdk.AccAddress
+		want    []deployedContract
+	}{
+		{
+			name: "top-level create by the message sender, not the first signer",
+			events: []abci.Event{
+				event(sdk.EventTypeMessage, sdk.AttributeKeySender, bech32(sender), msgIndexAttributeKey, "0"),
+				event(evmtypes.EventTypeContractCreated, evmtypes.AttributeKeyContract, hexAddress(contract), msgIndexAttributeKey, "0"),
+				event(evmtypes.EventTypeCreate, evmtypes.AttributeKeyContract, hexAddress(contract), msgIndexAttributeKey, "0"),
+			},
+			signers: []sdk.AccAddress{feePayer, sender},
+			want:    []deployedContract{{contract, evm.ContractTypeUnspecified, sender}},
+		},
+		{
+			name: "factory creation in a call of the second message",
+			events: []abci.Event{
+				event(sdk.EventTypeMessage, sdk.AttributeKeySender, bech32(feePayer), msgIndexAttributeKey, "0"),
+				event(sdk.EventTypeMessage, sdk.AttributeKeySender, bech32(sender), msgIndexAttributeKey, "1"),
+				event(evmtypes.EventTypeCall, evmtypes.AttributeKeyContract, hexAddress(factory), msgIndexAttributeKey, "1"),
+				event(evmtypes.EventTypeContractCreated, evmtypes.AttributeKeyContract, hexAddress(child), msgIndexAttributeKey, "1"),
+				event(evmtypes.EventTypeERC20Created, evmtypes.AttributeKeyContract, hexAddress(child), msgIndexAttributeKey, "1"),
+			},
+			signers: []sdk.AccAddress{feePayer, sender},
+			want:    []deployedContract{{child, evm.ContractTypeERC20, sender}},
+		},
+		{
+			name: "signer fallback for a top-level create",
+			events: []abci.Event{
+				event(evmtypes.EventTypeCreate, evmtypes.AttributeKeyContract, hexAddress(contract)),
+			},
+			signers: []sdk.AccAddress{sender},
+			want:    []deployedContract{{contract, evm.ContractTypeUnspecified, sender}},
+		},
+		{
+			name: "no signer fallback for a nested creation",
+			events: []abci.Event{
+				event(evmtypes.EventTypeContractCreated, evmtypes.AttributeKeyContract, hexAddress(child)),
+			},
+			signers: []sdk.AccAddress{sender},
+			want:    []deployedContract{{child, evm.ContractTypeUnspecified, nil}},
+		},
+		{
+			name: "no signer fallback for multiple signers",
+			events: []abci.Event{
+				event(evmtypes.EventTypeCreate, evmtypes.AttributeKeyContract, hexAddress(contract)),
+			},
+			signers: []sdk.AccAddress{feePayer, sender},
+			want:    []deployedContract{{contract, evm.ContractTypeUnspecified, nil}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := grepContracts(ac, &sdk.TxResponse{Events: tc.events}, tc.signers)
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %d contracts, want %d", len(got), len(tc.want))
+			}
+			for i, want := range tc.want {
+				if !got[i].addr.Equals(want.addr) || got[i].contractType != want.contractType || !got[i].deployer.Equals(want.deployer) {
+					t.Errorf("contract %d: got %s %s deployed by %s, want %s %s deployed by %s", i,
+						got[i].addr, got[i].contractType, got[i].deployer, want.addr, want.contractType, want.deployer)
+				}
+			}
+		})
+	}
+}
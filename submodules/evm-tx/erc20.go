@@ -0,0 +1,121 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/initia-labs/kvindexer/submodules/evm-tx/types/evm"
+	evmtypes "github.com/initia-labs/minievm/x/evm/types"
+)
+
+// erc20Transfer is the decoded ERC-20 transfer with the addresses used as the index keys
+type erc20Transfer struct {
+	transfer evm.ERC20Transfer
+	contract sdk.AccAddress
+	from     sdk.AccAddress
+	to       sdk.AccAddress
+}
+
+// accounts returns the sender and the recipient of the transfer without duplication
+func (t erc20Transfer) accounts() []sdk.AccAddress {
+	if t.from.Equals(t.to) {
+		return []sdk.AccAddress{t.from}
+	}
+	return []sdk.AccAddress{t.from, t.to}
+}
+
+// grepERC20Transfers returns the ERC-20 transfers of the tx
+func grepERC20Transfers(txr *sdk.TxResponse) []erc20Transfer {
+	transfers := []erc20Transfer{}
+	for _, l := range grepEvmLogs(txr) {
+		contract, from, to, amount, ok := erc20TransferFromLog(l.log)
+		if !ok {
+			continue
+		}
+
+		transfers = append(transfers, erc20Transfer{
+			transfer: evm.ERC20Transfer{
+				Contract: hexAddress(contract),
+				From:     hexAddress(from),
+				To:       hexAddress(to),
+				Amount:   amount,
+				TxHash:   txr.TxHash,
+				Height:   txr.Height,
+				LogIndex: l.index,
+			},
+			contract: contract,
+			from:     from,
+			to:       to,
+		})
+	}
+	return transfers
+}
+
+// erc20TransferFromLog decodes the Transfer log of an ERC-20 token. The Transfer log of an ERC-721
+// token is excluded as it has the token id as the third indexed topic instead of the data.
+func erc20TransferFromLog(log evmtypes.Log) (contract, from, to sdk.AccAddress, amount math.Int, ok bool) {
+	if len(log.Topics) != 3 || log.Topics[0] != transferTopic {
+		return
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(log.Data, "0x"))
+	if err != nil || len(data) == 0 || len(data) > common.HashLength {
+		return
+	}
+
+	if contract, err = evmAddressFromHex(log.Address); err != nil {
+		return
+	}
+	if from, err = evmAddressFromHex(log.Topics[1]); err != nil {
+		return
+	}
+	if to, err = evmAddressFromHex(log.Topics[2]); err != nil {
+		return
+	}
+
+	return contract, from, to, math.NewIntFromBigInt(new(big.Int).SetBytes(data)), true
+}
+
+// storeERC20Transfers stores the ERC-20 transfers of the tx keyed by its sequence
+func (sm EvmTxSubmodule) storeERC20Transfers(ctx context.Context, seq uint64, txr *sdk.TxResponse) error {
+	for _, t := range grepERC20Transfers(txr) {
+		for _, acc := range t.accounts() {
+			if err := sm.erc20TransfersByAccountMap.Set(ctx, collections.Join3(acc, seq, t.transfer.LogIndex), t.transfer); err != nil {
+				return err
+			}
+		}
+
+		if err := sm.erc20TransfersByContractMap.Set(ctx, collections.Join3(t.contract, seq, t.transfer.LogIndex), t.transfer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeERC20Transfers removes the ERC-20 transfers of the tx keyed by its sequence
+func (sm EvmTxSubmodule) removeERC20Transfers(ctx context.Context, seq uint64, txr *sdk.TxResponse) error {
+	for _, t := range grepERC20Transfers(txr) {
+		for _, acc := range t.accounts() {
+			if err := sm.erc20TransfersByAccountMap.Remove(ctx, collections.Join3(acc, seq, t.transfer.LogIndex)); err != nil {
+				return err
+			}
+		}
+
+		if err := sm.erc20TransfersByContractMap.Remove(ctx, collections.Join3(t.contract, seq, t.transfer.LogIndex)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hexAddress returns the checksummed hex address of the account
+func hexAddress(acc sdk.AccAddress) string {
+	return common.BytesToAddress(acc).Hex()
+}
@@ -0,0 +1,54 @@
+package tx
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/initia-labs/minievm/x/evm/types"
+)
+
+func TestERC20TransferFromLog(t *testing.T) {
+	contract := sdk.AccAddress(append(make([]byte, 19), 1))
+	from := sdk.AccAddress(append(make([]byte, 19), 2))
+	to := sdk.AccAddress(append(make([]byte, 19), 3))
+
+	contractHex := "0x0000000000000000000000000000000000000001"
+	fromTopic := "0x0000000000000000000000000000000000000000000000000000000000000002"
+	toTopic := "0x0000000000000000000000000000000000000000000000000000000000000003"
+	amountData := "0x00000000000000000000000000000000000000000000000000000000000003e8"
+
+	tests := []struct {
+		name   string
+		log    evmtypes.Log
+		amount math.Int
+		ok     bool
+	}{
+		{"erc20 transfer", evmtypes.Log{Address: contractHex, Topics: []string{transferTopic, fromTopic, toTopic}, Data: amountData}, math.NewInt(1000), true},
+		{"short data", evmtypes.Log{Address: contractHex, Topics: []string{transferTopic, fromTopic, toTopic}, Data: "0x03e8"}, math.NewInt(1000), true},
+		{"erc721 transfer", evmtypes.Log{Address: contractHex, Topics: []string{transferTopic, fromTopic, toTopic, toTopic}, Data: "0x"}, math.Int{}, false},
+		{"other event", evmtypes.Log{Address: contractHex, Topics: []string{toTopic, fromTopic, toTopic}, Data: amountData}, math.Int{}, false},
+		{"empty data", evmtypes.Log{Address: contractHex, Topics: []string{transferTopic, fromTopic, toTopic}, Data: "0x"}, math.Int{}, false},
+		{"too long data", evmtypes.Log{Address: contractHex, Topics: []string{transferTopic, fromTopic, toTopic}, Data: amountData + "00"}, math.Int{}, false},
+		{"non-hex data", evmtypes.Log{Address: contractHex, Topics: []string{transferTopic, fromTopic, toTopic}, Data: "0xzz"}, math.Int{}, false},
+		{"invalid topic", evmtypes.Log{Address: contractHex, Topics: []string{transferTopic, "0xzz", toTopic}, Data: amountData}, math.Int{}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotContract, gotFrom, gotTo, amount, ok := erc20TransferFromLog(tc.log)
+			if ok != tc.ok {
+				t.Fatalf("got ok %v, want %v", ok, tc.ok)
+			}
+			if !ok {
+				return
+			}
+			if !gotContract.Equals(contract) || !gotFrom.Equals(from) || !gotTo.Equals(to) {
+				t.Errorf("got %s, %s and %s, want %s, %s and %s", gotContract, gotFrom, gotTo, contract, from, to)
+			}
+			if !amount.Equal(tc.amount) {
+				t.Errorf("got amount %s, want %s", amount, tc.amount)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package tx
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmkeeper "github.com/initia-labs/minievm/x/evm/keeper"
+)
+
+// evmTxHasher returns the EVM tx hash of the tx. found is false if the tx is not an EVM tx.
+type evmTxHasher func(ctx context.Context, txBytes []byte) (evmTxHash common.Hash, found bool, err error)
+
+// newEvmTxHasher returns the hasher deriving the EVM tx hash from the ethereum tx
+// converted by the evm keeper. It must be called with the block context.
+func newEvmTxHasher(vmKeeper *evmkeeper.Keeper, txDecoder sdk.TxDecoder) evmTxHasher {
+	return func(ctx context.Context, txBytes []byte) (common.Hash, bool, error) {
+		sdkTx, err := txDecoder(txBytes)
+		if err != nil {
+			return common.Hash{}, false, err
+		}
+
+		ethTx, _, err := evmkeeper.NewTxUtils(vmKeeper).ConvertCosmosTxToEthereumTx(ctx, sdkTx)
+		if err != nil {
+			return common.Hash{}, false, err
+		}
+		if ethTx == nil {
+			return common.Hash{}, false, nil
+		}
+
+		return ethTx.Hash(), true, nil
+	}
+}
+
+// storeEvmTxHash stores the mapping between the EVM tx hash and the tx hash in both directions
+func (sm EvmTxSubmodule) storeEvmTxHash(ctx context.Context, txHash string, txBytes []byte) error {
+	hash, found, err := sm.evmTxHasher(ctx, txBytes)
+	if err != nil || !found {
+		return err
+	}
+
+	evmTxHash := hash.Hex()
+	if err = sm.txHashByEvmTxHashMap.Set(ctx, evmTxHash, txHash); err != nil {
+		return err
+	}
+	return sm.evmTxHashByTxHashMap.Set(ctx, txHash, evmTxHash)
+}
+
+// removeEvmTxHash removes the mapping between the EVM tx hash and the tx hash
+func (sm EvmTxSubmodule) removeEvmTxHash(ctx context.Context, txHash string) error {
+	evmTxHash, err := sm.evmTxHashByTxHashMap.Get(ctx, txHash)
+	if err != nil {
+		if cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err = sm.txHashByEvmTxHashMap.Remove(ctx, evmTxHash); err != nil {
+		return err
+	}
+	return sm.evmTxHashByTxHashMap.Remove(ctx, txHash)
+}
@@ -0,0 +1,133 @@
+package tx
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/initia-labs/minievm/x/evm/types"
+
+	txindexer "github.com/initia-labs/kvindexer/tx"
+	txtypes "github.com/initia-labs/kvindexer/tx/types"
+)
+
+const (
+	transferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+)
+
+var _ txindexer.AccountExtractor = evmAccountExtractor{}
+
+// evmAccountExtractor finds the accounts in the logs and the contract addresses of the evm module events
+type evmAccountExtractor struct {
+	ac address.Codec
+}
+
+// ExtractAddresses implements txindexer.AccountExtractor.
+func (e evmAccountExtractor) ExtractAddresses(eventType, key, value string) ([]string, bool) {
+	switch {
+	case eventType == evmtypes.EventTypeEVM && key == evmtypes.AttributeKeyLog:
+		addrs, err := extractAddressesFromEVMLog(e.ac, value)
+		if err != nil {
+			return nil, true
+		}
+		return addrs, true
+	case isEvmModuleEvent(eventType) && key == evmtypes.AttributeKeyContract:
+		addr, err := convertContractAddressToBech32(e.ac, value)
+		if err != nil {
+			return nil, true
+		}
+		return []string{addr}, true
+	default:
+		return nil, false
+	}
+}
+
+// ExtractRoles implements txindexer.AccountExtractor.
+func (evmAccountExtractor) ExtractRoles(eventType, key, value string) ([]txindexer.AccountRole, bool) {
+	if eventType != evmtypes.EventTypeEVM || key != evmtypes.AttributeKeyLog {
+		return nil, false
+	}
+
+	// the sender and the recipient of the erc20 and erc721 transfers
+	from, to, ok := transferAddressesFromEVMLog(value)
+	if !ok {
+		return nil, true
+	}
+	return []txindexer.AccountRole{
+		{Account: from, Role: txtypes.TxRoleSender},
+		{Account: to, Role: txtypes.TxRoleRecipient},
+	}, true
+}
+
+// isEvmModuleEvent checks if the event type is from evm module except evmtypes.EventTypeEVM.
+// return true if it is, false otherwise.
+func isEvmModuleEvent(eventType string) bool {
+	switch eventType {
+	case evmtypes.EventTypeCall, evmtypes.EventTypeCreate,
+		evmtypes.EventTypeContractCreated, evmtypes.EventTypeERC20Created,
+		evmtypes.EventTypeERC721Created, evmtypes.EventTypeERC721Minted, evmtypes.EventTypeERC721Burned:
+		return true
+	default:
+		return false
+	}
+}
+
+func extractAddressesFromEVMLog(ac address.Codec, attrVal string) (addrs []string, err error) {
+	log := evmtypes.Log{}
+	if err = json.Unmarshal([]byte(attrVal), &log); err != nil {
+		return
+	}
+	var addr string
+	addr, err = convertContractAddressToBech32(ac, log.Address)
+	if err == nil {
+		addrs = append(addrs, addr)
+	}
+
+	// if the topic is about transfer, we need to extract the addresses from the topics.
+	if log.Topics == nil { // no topic
+		return
+	}
+	topicLen := len(log.Topics)
+	if topicLen < 2 { // no data to extract
+		return
+	}
+	if log.Topics[0] != transferTopic { // topic is not about transfer
+		return
+	}
+
+	for i := 1; i < topicLen; i++ {
+		if i == 3 { // if index is 3, it means index indicates the amount, not address. need break
+			break
+		}
+		addr, err = convertContractAddressToBech32(ac, log.Topics[i])
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return
+}
+
+// transferAddressesFromEVMLog returns the from and to addresses of the transfer log
+func transferAddressesFromEVMLog(attrVal string) (from, to sdk.AccAddress, ok bool) {
+	log := evmtypes.Log{}
+	if err := json.Unmarshal([]byte(attrVal), &log); err != nil {
+		return nil, nil, false
+	}
+	if len(log.Topics) < 3 || log.Topics[0] != transferTopic {
+		return nil, nil, false
+	}
+
+	from, err := evmAddressFromHex(log.Topics[1])
+	if err != nil {
+		return nil, nil, false
+	}
+	to, err = evmAddressFromHex(log.Topics[2])
+	if err != nil {
+		return nil, nil, false
+	}
+
+	return from, to, true
+}
@@ -0,0 +1,358 @@
+package tx
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/initia-labs/kvindexer/collection"
+	"github.com/initia-labs/kvindexer/submodules/evm-tx/types/evm"
+	txindexer "github.com/initia-labs/kvindexer/tx"
+	txtypes "github.com/initia-labs/kvindexer/tx/types"
+	"github.com/initia-labs/kvindexer/util"
+)
+
+var _ evm.QueryServer = (*EvmQuerier)(nil)
+
+// EvmQuerier serves the queries of the EVM specific data of the txs
+type EvmQuerier struct {
+	EvmTxSubmodule
+}
+
+func NewEvmQuerier(sb EvmTxSubmodule) evm.QueryServer {
+	return EvmQuerier{sb}
+}
+
+// maxEvmTxHashes is the maximum number of the tx hashes queried at once by EvmTxHashes
+const maxEvmTxHashes = 100
+
+// TxByEvmHash implements evm.QueryServer.
+func (q EvmQuerier) TxByEvmHash(ctx context.Context, req *evm.QueryTxByEvmHashRequest) (*evm.QueryTxByEvmHashResponse, error) {
+	if req.EvmTxHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty evm tx hash")
+	}
+
+	evmTxHash, err := txindexer.NormalizeHash(req.EvmTxHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txHash, err := q.txHashByEvmTxHashMap.Get(ctx, evmTxHash)
+	if err != nil {
+		if cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "tx not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tx, err := q.GetTx(ctx, txHash)
+	if err != nil {
+		if cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "tx not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &evm.QueryTxByEvmHashResponse{Tx: &tx, EvmTxHash: evmTxHash}, nil
+}
+
+// EvmTxHashes implements evm.QueryServer.
+func (q EvmQuerier) EvmTxHashes(ctx context.Context, req *evm.QueryEvmTxHashesRequest) (*evm.QueryEvmTxHashesResponse, error) {
+	if len(req.TxHashes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx hashes")
+	}
+	if len(req.TxHashes) > maxEvmTxHashes {
+		return nil, status.Errorf(codes.InvalidArgument, "too many tx hashes: %d > %d", len(req.TxHashes), maxEvmTxHashes)
+	}
+
+	evmTxHashes := map[string]string{}
+	for _, txHash := range req.TxHashes {
+		if txHash == "" {
+			return nil, status.Error(codes.InvalidArgument, "empty tx hash")
+		}
+
+		txHash = strings.ToLower(txHash)
+		evmTxHash, err := q.evmTxHashByTxHashMap.Get(ctx, txHash)
+		if err != nil {
+			if cosmoserr.IsOf(err, collections.ErrNotFound) {
+				continue
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		evmTxHashes[txHash] = evmTxHash
+	}
+
+	return &evm.QueryEvmTxHashesResponse{EvmTxHashes: evmTxHashes}, nil
+}
+
+// Txs implements evm.QueryServer.
+func (q EvmQuerier) Txs(ctx context.Context, req *txtypes.QueryTxsRequest) (*evm.QueryEvmTxsResponse, error) {
+	res, err := txindexer.NewQuerier(q.Indexer).Txs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return q.withEvmTxHashes(ctx, res)
+}
+
+// TxsByAccount implements evm.QueryServer.
+func (q EvmQuerier) TxsByAccount(ctx context.Context, req *txtypes.QueryTxsByAccountRequest) (*evm.QueryEvmTxsResponse, error) {
+	res, err := txindexer.NewQuerier(q.Indexer).TxsByAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return q.withEvmTxHashes(ctx, res)
+}
+
+// TxsByHeight implements evm.QueryServer.
+func (q EvmQuerier) TxsByHeight(ctx context.Context, req *txtypes.QueryTxsByHeightRequest) (*evm.QueryEvmTxsResponse, error) {
+	res, err := txindexer.NewQuerier(q.Indexer).TxsByHeight(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return q.withEvmTxHashes(ctx, res)
+}
+
+// withEvmTxHashes pairs the txs queried from the tx indexer with their EVM tx hashes
+func (q EvmQuerier) withEvmTxHashes(ctx context.Context, res *txtypes.QueryTxsResponse) (*evm.QueryEvmTxsResponse, error) {
+	txs := make([]evm.EvmTx, 0, len(res.Txs))
+	for _, tx := range res.Txs {
+		evmTxHash, err := q.evmTxHashByTxHashMap.Get(ctx, strings.ToLower(tx.TxHash))
+		if err != nil && !cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		txs = append(txs, evm.EvmTx{Tx: tx, EvmTxHash: evmTxHash})
+	}
+
+	return &evm.QueryEvmTxsResponse{
+		Txs:        txs,
+		Pagination: res.Pagination,
+	}, nil
+}
+
+// ERC20TransfersByAccount implements evm.QueryServer.
+func (q EvmQuerier) ERC20TransfersByAccount(ctx context.Context, req *evm.QueryERC20TransfersByAccountRequest) (*evm.QueryERC20TransfersResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.Account == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty account")
+	}
+
+	acc, err := txindexer.AccAddressFromString(q.ac, req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var contract string
+	if req.Contract != "" {
+		contractAcc, err := txindexer.AccAddressFromString(q.ac, req.Contract)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		contract = hexAddress(contractAcc)
+	}
+
+	transfers, pageRes, err := query.CollectionFilteredPaginate(ctx, q.erc20TransfersByAccountMap, req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, uint64, uint32], transfer evm.ERC20Transfer) (bool, error) {
+			return contract == "" || transfer.Contract == contract, nil
+		},
+		func(_ collections.Triple[sdk.AccAddress, uint64, uint32], transfer evm.ERC20Transfer) (evm.ERC20Transfer, error) {
+			return transfer, nil
+		},
+		collection.WithCollectionPaginationTriplePrefix[sdk.AccAddress, uint64, uint32](acc),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &evm.QueryERC20TransfersResponse{
+		Transfers:  transfers,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ERC20TransfersByContract implements evm.QueryServer.
+func (q EvmQuerier) ERC20TransfersByContract(ctx context.Context, req *evm.QueryERC20TransfersByContractRequest) (*evm.QueryERC20TransfersResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.Contract == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty contract")
+	}
+
+	contract, err := txindexer.AccAddressFromString(q.ac, req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	transfers, pageRes, err := query.CollectionPaginate(ctx, q.erc20TransfersByContractMap, req.Pagination,
+		func(_ collections.Triple[sdk.AccAddress, uint64, uint32], transfer evm.ERC20Transfer) (evm.ERC20Transfer, error) {
+			return transfer, nil
+		},
+		collection.WithCollectionPaginationTriplePrefix[sdk.AccAddress, uint64, uint32](contract),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &evm.QueryERC20TransfersResponse{
+		Transfers:  transfers,
+		Pagination: pageRes,
+	}, nil
+}
+
+// EvmLogs implements evm.QueryServer.
+func (q EvmQuerier) EvmLogs(ctx context.Context, req *evm.QueryEvmLogsRequest) (*evm.QueryEvmLogsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative height")
+	}
+
+	filter, err := newEvmLogFilter(q.ac, req.Addresses, req.Topics)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	startSeq, endSeq := uint64(0), uint64(math.MaxUint64)
+	if req.FromHeight > 0 || req.ToHeight > 0 {
+		if startSeq, endSeq, err = q.SequenceRange(ctx, req.FromHeight, req.ToHeight); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// use the most selective index, and the rest of the filter is applied to the logs
+	var logs []evm.EvmLog
+	var pageRes *query.PageResponse
+	topic0 := filter.topic0()
+	switch {
+	case len(filter.contracts) == 1 && topic0 != "":
+		contract := filter.contracts[0]
+		start := collections.Join3(contract, topic0, collections.Join(startSeq, uint32(0)))
+		end := collections.Join3(contract, topic0, collections.Join(endSeq, uint32(0)))
+		logs, pageRes, err = util.IndexRangePaginate(ctx, q.evmLogsByContractTopicMap, q.evmLogMap, &start, &end, req.Pagination,
+			func(key collections.Triple[sdk.AccAddress, string, collections.Pair[uint64, uint32]]) collections.Pair[uint64, uint32] {
+				return key.K3()
+			},
+			filter.matchesLog,
+		)
+	case len(filter.contracts) == 1:
+		contract := filter.contracts[0]
+		start := collections.Join3(contract, startSeq, uint32(0))
+		end := collections.Join3(contract, endSeq, uint32(0))
+		logs, pageRes, err = util.IndexRangePaginate(ctx, q.evmLogsByContractMap, q.evmLogMap, &start, &end, req.Pagination,
+			func(key collections.Triple[sdk.AccAddress, uint64, uint32]) collections.Pair[uint64, uint32] {
+				return collections.Join(key.K2(), key.K3())
+			},
+			filter.matchesLog,
+		)
+	case topic0 != "":
+		start := collections.Join3(topic0, startSeq, uint32(0))
+		end := collections.Join3(topic0, endSeq, uint32(0))
+		logs, pageRes, err = util.IndexRangePaginate(ctx, q.evmLogsByTopicMap, q.evmLogMap, &start, &end, req.Pagination,
+			func(key collections.Triple[string, uint64, uint32]) collections.Pair[uint64, uint32] {
+				return collections.Join(key.K2(), key.K3())
+			},
+			filter.matchesLog,
+		)
+	default:
+		// the logs are scanned in the height range, so it must be bounded unless all of them match
+		if !filter.isEmpty() && (req.FromHeight == 0 || req.ToHeight == 0 || req.ToHeight-req.FromHeight >= maxEvmLogHeightRange) {
+			return nil, status.Errorf(codes.InvalidArgument, "from_height and to_height within %d heights are required unless a single address or a single topic0 is given", maxEvmLogHeightRange)
+		}
+
+		start := collections.Join(startSeq, uint32(0))
+		end := collections.Join(endSeq, uint32(0))
+		logs, pageRes, err = util.CollectionRangePaginate(ctx, q.evmLogMap, &start, &end, req.Pagination,
+			func(_ collections.Pair[uint64, uint32], log evm.EvmLog) (bool, error) {
+				return filter.matches(log), nil
+			},
+			func(_ collections.Pair[uint64, uint32], log evm.EvmLog) (evm.EvmLog, error) {
+				return log, nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &evm.QueryEvmLogsResponse{
+		Logs:       logs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Contract implements evm.QueryServer.
+func (q EvmQuerier) Contract(ctx context.Context, req *evm.QueryContractRequest) (*evm.QueryContractResponse, error) {
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	addr, err := txindexer.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	contract, err := q.contractMap.Get(ctx, addr)
+	if err != nil {
+		if cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "contract not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &evm.QueryContractResponse{Contract: &contract}, nil
+}
+
+// ContractsByDeployer implements evm.QueryServer.
+func (q EvmQuerier) ContractsByDeployer(ctx context.Context, req *evm.QueryContractsByDeployerRequest) (*evm.QueryContractsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.Deployer == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty deployer")
+	}
+
+	deployer, err := txindexer.AccAddressFromString(q.ac, req.Deployer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	contracts, pageRes, err := query.CollectionPaginate(ctx, q.contractsByDeployerMap, req.Pagination,
+		func(key collections.Triple[sdk.AccAddress, uint64, sdk.AccAddress], _ bool) (evm.Contract, error) {
+			return q.contractMap.Get(ctx, key.K3())
+		},
+		collection.WithCollectionPaginationTriplePrefix[sdk.AccAddress, uint64, sdk.AccAddress](deployer),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &evm.QueryContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ContractsByType implements evm.QueryServer.
+func (q EvmQuerier) ContractsByType(ctx context.Context, req *evm.QueryContractsByTypeRequest) (*evm.QueryContractsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.Type != evm.ContractTypeERC20 && req.Type != evm.ContractTypeERC721 {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract type")
+	}
+
+	contracts, pageRes, err := query.CollectionPaginate(ctx, q.contractsByTypeMap, req.Pagination,
+		func(key collections.Triple[int32, uint64, sdk.AccAddress], _ bool) (evm.Contract, error) {
+			return q.contractMap.Get(ctx, key.K3())
+		},
+		collection.WithCollectionPaginationTriplePrefix[int32, uint64, sdk.AccAddress](int32(req.Type)),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &evm.QueryContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
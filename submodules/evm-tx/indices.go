@@ -0,0 +1,37 @@
+package tx
+
+import (
+	"context"
+
+	txindexer "github.com/initia-labs/kvindexer/tx"
+)
+
+// StoreTx implements txindexer.IndexHook. It stores the EVM specific indices of the tx.
+func (sm EvmTxSubmodule) StoreTx(ctx context.Context, seq uint64, idx txindexer.IndexedTx) error {
+	if err := sm.storeEvmTxHash(ctx, idx.Hash, idx.TxBytes); err != nil {
+		return err
+	}
+
+	if err := sm.storeERC20Transfers(ctx, seq, idx.TxResponse); err != nil {
+		return err
+	}
+
+	if err := sm.storeEvmLogs(ctx, seq, idx); err != nil {
+		return err
+	}
+
+	return sm.storeContracts(ctx, seq, idx)
+}
+
+// RemoveTx implements txindexer.IndexHook. The contracts are not removed as they are the registry
+// of the contracts on the chain.
+func (sm EvmTxSubmodule) RemoveTx(ctx context.Context, seq uint64, idx txindexer.IndexedTx) error {
+	if err := sm.removeERC20Transfers(ctx, seq, idx.TxResponse); err != nil {
+		return err
+	}
+	if err := sm.removeEvmLogs(ctx, seq, idx.TxResponse); err != nil {
+		return err
+	}
+
+	return sm.removeEvmTxHash(ctx, idx.Hash)
+}
@@ -0,0 +1,117 @@
+package tx
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/submodules/evm-tx/types/evm"
+	txindexer "github.com/initia-labs/kvindexer/tx"
+)
+
+const (
+	// maxEvmLogAddresses is the maximum number of the contract addresses of the log filter
+	maxEvmLogAddresses = 100
+	// maxEvmLogTopics is the maximum number of the topic positions of the log filter
+	maxEvmLogTopics = 4
+	// maxEvmLogHeightRange is the maximum height range of the logs filtered without the contract or the topic index
+	maxEvmLogHeightRange = 10000
+)
+
+// evmLogFilter matches the logs by the contract addresses and the topics at each position as eth_getLogs does
+type evmLogFilter struct {
+	contracts []sdk.AccAddress
+	addresses map[string]bool
+	// topics are the allowed topics at each position. nil allows any topic.
+	topics []map[string]bool
+}
+
+func newEvmLogFilter(ac address.Codec, addresses []string, topics []evm.TopicFilter) (evmLogFilter, error) {
+	if len(addresses) > maxEvmLogAddresses {
+		return evmLogFilter{}, fmt.Errorf("too many addresses: %d > %d", len(addresses), maxEvmLogAddresses)
+	}
+	if len(topics) > maxEvmLogTopics {
+		return evmLogFilter{}, fmt.Errorf("too many topics: %d > %d", len(topics), maxEvmLogTopics)
+	}
+
+	filter := evmLogFilter{}
+	for _, addr := range addresses {
+		acc, err := txindexer.AccAddressFromString(ac, addr)
+		if err != nil {
+			return evmLogFilter{}, err
+		}
+
+		if filter.addresses == nil {
+			filter.addresses = map[string]bool{}
+		}
+		if hexAddr := hexAddress(acc); !filter.addresses[hexAddr] {
+			filter.addresses[hexAddr] = true
+			filter.contracts = append(filter.contracts, acc)
+		}
+	}
+
+	for _, topic := range topics {
+		var allowed map[string]bool
+		for _, value := range topic.Values {
+			normalized, err := txindexer.NormalizeHash(value)
+			if err != nil {
+				return evmLogFilter{}, fmt.Errorf("invalid topic %s: %w", value, err)
+			}
+
+			if allowed == nil {
+				allowed = map[string]bool{}
+			}
+			allowed[normalized] = true
+		}
+		filter.topics = append(filter.topics, allowed)
+	}
+
+	return filter, nil
+}
+
+// topic0 returns the topic at the first position if only one is allowed
+func (f evmLogFilter) topic0() string {
+	if len(f.topics) == 0 || len(f.topics[0]) != 1 {
+		return ""
+	}
+	for topic := range f.topics[0] {
+		return topic
+	}
+	return ""
+}
+
+// isEmpty returns whether the filter matches all the logs
+func (f evmLogFilter) isEmpty() bool {
+	if f.addresses != nil {
+		return false
+	}
+	for _, allowed := range f.topics {
+		if allowed != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (f evmLogFilter) matches(log evm.EvmLog) bool {
+	if f.addresses != nil && !f.addresses[log.Address] {
+		return false
+	}
+
+	for i, allowed := range f.topics {
+		if allowed == nil {
+			continue
+		}
+		if i >= len(log.Topics) || !allowed[log.Topics[i]] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesLog is matches in the form of the predicate of util.IndexRangePaginate
+func (f evmLogFilter) matchesLog(log evm.EvmLog) (bool, error) {
+	return f.matches(log), nil
+}
@@ -0,0 +1,113 @@
+package tx
+
+import (
+	"strings"
+	"testing"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/submodules/evm-tx/types/evm"
+)
+
+func TestNewEvmLogFilter(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("init")
+	contract := sdk.AccAddress(append(make([]byte, 19), 1))
+	bech32Contract, err := ac.BytesToString(contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contractHex := hexAddress(contract)
+	topic := "0x" + strings.Repeat("ab", 32)
+
+	tooManyAddresses := make([]string, maxEvmLogAddresses+1)
+	for i := range tooManyAddresses {
+		tooManyAddresses[i] = contractHex
+	}
+
+	tests := []struct {
+		name      string
+		addresses []string
+		topics    []evm.TopicFilter
+		wantErr   bool
+		// contracts is the number of the contracts, and allowed are the numbers of the allowed topics at each position
+		contracts int
+		allowed   []int
+		isEmpty   bool
+		topic0    string
+	}{
+		{name: "empty", isEmpty: true},
+		{name: "wildcard topics", topics: []evm.TopicFilter{{}, {}}, allowed: []int{0, 0}, isEmpty: true},
+		{name: "hex and bech32 of the same contract", addresses: []string{contractHex, bech32Contract, "0x1"}, contracts: 1},
+		{name: "topic0", topics: []evm.TopicFilter{{Values: []string{strings.ToUpper(topic)}}}, allowed: []int{1}, topic0: topic},
+		{name: "topic1 of alternatives", topics: []evm.TopicFilter{{}, {Values: []string{topic, "0x" + strings.Repeat("cd", 32)}}}, allowed: []int{0, 2}},
+		{name: "invalid address", addresses: []string{"init1invalid"}, wantErr: true},
+		{name: "invalid topic", topics: []evm.TopicFilter{{Values: []string{"0xabcd"}}}, wantErr: true},
+		{name: "too many addresses", addresses: tooManyAddresses, wantErr: true},
+		{name: "too many topics", topics: make([]evm.TopicFilter, maxEvmLogTopics+1), wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := newEvmLogFilter(ac, tc.addresses, tc.topics)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			if len(filter.contracts) != tc.contracts || len(filter.addresses) != tc.contracts {
+				t.Errorf("got %d contracts and %d addresses, want %d", len(filter.contracts), len(filter.addresses), tc.contracts)
+			}
+			if tc.contracts > 0 && !filter.addresses[contractHex] {
+				t.Errorf("got addresses %v, want %s", filter.addresses, contractHex)
+			}
+			if len(filter.topics) != len(tc.allowed) {
+				t.Fatalf("got %d topic positions, want %d", len(filter.topics), len(tc.allowed))
+			}
+			for i, n := range tc.allowed {
+				if len(filter.topics[i]) != n || (n == 0) != (filter.topics[i] == nil) {
+					t.Errorf("got topics %v at %d, want %d", filter.topics[i], i, n)
+				}
+			}
+			if filter.isEmpty() != tc.isEmpty {
+				t.Errorf("got isEmpty %v, want %v", filter.isEmpty(), tc.isEmpty)
+			}
+			if filter.topic0() != tc.topic0 {
+				t.Errorf("got topic0 %s, want %s", filter.topic0(), tc.topic0)
+			}
+		})
+	}
+}
+
+func TestEvmLogFilterMatches(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("init")
+	contractHex := hexAddress(sdk.AccAddress(append(make([]byte, 19), 1)))
+	otherHex := hexAddress(sdk.AccAddress(append(make([]byte, 19), 2)))
+	topicA := "0x" + strings.Repeat("aa", 32)
+	topicB := "0x" + strings.Repeat("bb", 32)
+
+	filter, err := newEvmLogFilter(ac, []string{contractHex}, []evm.TopicFilter{{}, {Values: []string{topicA, topicB}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		log  evm.EvmLog
+		want bool
+	}{
+		{"topic1 matched", evm.EvmLog{Address: contractHex, Topics: []string{topicB, topicA}}, true},
+		{"other topic1 matched", evm.EvmLog{Address: contractHex, Topics: []string{topicA, topicB, topicA}}, true},
+		{"other contract", evm.EvmLog{Address: otherHex, Topics: []string{topicA, topicA}}, false},
+		{"topic1 not matched", evm.EvmLog{Address: contractHex, Topics: []string{topicA, topicA[:len(topicA)-1] + "b"}}, false},
+		{"no topic1", evm.EvmLog{Address: contractHex, Topics: []string{topicA}}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := filter.matches(tc.log); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package tx
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/submodules/evm-tx/types/evm"
+	txindexer "github.com/initia-labs/kvindexer/tx"
+	evmtypes "github.com/initia-labs/minievm/x/evm/types"
+)
+
+// evmLog is the evm log emitted by the tx with its index in the tx
+type evmLog struct {
+	index uint32
+	log   evmtypes.Log
+}
+
+// grepEvmLogs returns the evm logs of the tx in the emitted order.
+// the index is kept for the logs following an unparsable one.
+func grepEvmLogs(txr *sdk.TxResponse) []evmLog {
+	logs := []evmLog{}
+	var index uint32
+	for _, event := range txr.Events {
+		if event.Type != evmtypes.EventTypeEVM {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyLog {
+				continue
+			}
+
+			log := evmtypes.Log{}
+			if err := json.Unmarshal([]byte(attr.Value), &log); err == nil {
+				logs = append(logs, evmLog{index, log})
+			}
+			index++
+		}
+	}
+	return logs
+}
+
+// newEvmLog returns the indexed log of the evm log emitted by the tx
+func newEvmLog(l evmLog, txr *sdk.TxResponse, blockIndex uint32) (evm.EvmLog, sdk.AccAddress, error) {
+	contract, err := evmAddressFromHex(l.log.Address)
+	if err != nil {
+		return evm.EvmLog{}, nil, err
+	}
+
+	topics := make([]string, len(l.log.Topics))
+	for i, topic := range l.log.Topics {
+		topics[i] = strings.ToLower(topic)
+	}
+
+	return evm.EvmLog{
+		Address:  hexAddress(contract),
+		Topics:   topics,
+		Data:     l.log.Data,
+		Height:   txr.Height,
+		TxHash:   txr.TxHash,
+		TxIndex:  blockIndex,
+		LogIndex: l.index,
+	}, contract, nil
+}
+
+// storeEvmLogs stores the evm logs of the tx keyed by its sequence and the log index
+func (sm EvmTxSubmodule) storeEvmLogs(ctx context.Context, seq uint64, idx txindexer.IndexedTx) error {
+	for _, l := range grepEvmLogs(idx.TxResponse) {
+		log, contract, err := newEvmLog(l, idx.TxResponse, idx.BlockIndex)
+		if err != nil {
+			continue
+		}
+
+		pos := collections.Join(seq, l.index)
+		if err = sm.evmLogMap.Set(ctx, pos, log); err != nil {
+			return err
+		}
+		if err = sm.evmLogsByContractMap.Set(ctx, collections.Join3(contract, seq, l.index), true); err != nil {
+			return err
+		}
+		if len(log.Topics) == 0 {
+			continue
+		}
+		if err = sm.evmLogsByContractTopicMap.Set(ctx, collections.Join3(contract, log.Topics[0], pos), true); err != nil {
+			return err
+		}
+		if err = sm.evmLogsByTopicMap.Set(ctx, collections.Join3(log.Topics[0], seq, l.index), true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeEvmLogs removes the evm logs of the tx keyed by its sequence and the log index
+func (sm EvmTxSubmodule) removeEvmLogs(ctx context.Context, seq uint64, txr *sdk.TxResponse) error {
+	for _, l := range grepEvmLogs(txr) {
+		log, contract, err := newEvmLog(l, txr, 0)
+		if err != nil {
+			continue
+		}
+
+		pos := collections.Join(seq, l.index)
+		if err = sm.evmLogMap.Remove(ctx, pos); err != nil {
+			return err
+		}
+		if err = sm.evmLogsByContractMap.Remove(ctx, collections.Join3(contract, seq, l.index)); err != nil {
+			return err
+		}
+		if len(log.Topics) == 0 {
+			continue
+		}
+		if err = sm.evmLogsByContractTopicMap.Remove(ctx, collections.Join3(contract, log.Topics[0], pos)); err != nil {
+			return err
+		}
+		if err = sm.evmLogsByTopicMap.Remove(ctx, collections.Join3(log.Topics[0], seq, l.index)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
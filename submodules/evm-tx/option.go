@@ -0,0 +1,33 @@
+package tx
+
+import (
+	txindexer "github.com/initia-labs/kvindexer/tx"
+)
+
+// Option configures the EvmTxSubmodule
+type Option func(*options)
+
+type options struct {
+	indexerOpts []txindexer.Option
+}
+
+// AddressExtractor returns the addresses found in the value of an event attribute
+type AddressExtractor = txindexer.AddressExtractor
+
+// DefaultEventIndexAllowlist is the default set of the event attributes indexed for TxsByEvents
+var DefaultEventIndexAllowlist = txindexer.DefaultEventIndexAllowlist
+
+// WithEventIndexAllowlist sets the event attributes indexed for TxsByEvents
+func WithEventIndexAllowlist(allowlist ...string) Option {
+	return func(o *options) {
+		o.indexerOpts = append(o.indexerOpts, txindexer.WithEventIndexAllowlist(allowlist...))
+	}
+}
+
+// WithAddressExtractor sets the extractor finding the account addresses in the attributes of the event.
+// The EVM logs and the contract addresses of the evm module events are always extracted by the submodule.
+func WithAddressExtractor(eventType, key string, extractor AddressExtractor) Option {
+	return func(o *options) {
+		o.indexerOpts = append(o.indexerOpts, txindexer.WithAddressExtractor(eventType, key, extractor))
+	}
+}
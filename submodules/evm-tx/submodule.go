@@ -4,143 +4,163 @@ import (
 	"context"
 
 	"cosmossdk.io/collections"
-	"cosmossdk.io/log"
-	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/core/address"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/gogoproto/grpc"
 
-	abci "github.com/cometbft/cometbft/abci/types"
-
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/initia-labs/kvindexer/collection"
 	"github.com/initia-labs/kvindexer/submodules/evm-tx/types"
+	"github.com/initia-labs/kvindexer/submodules/evm-tx/types/evm"
+	txindexer "github.com/initia-labs/kvindexer/tx"
 	kvindexer "github.com/initia-labs/kvindexer/x/kvindexer/types"
+
+	evmkeeper "github.com/initia-labs/minievm/x/evm/keeper"
 )
 
 var _ kvindexer.Submodule = EvmTxSubmodule{}
+var _ kvindexer.Verifier = EvmTxSubmodule{}
+var _ txindexer.IndexHook = EvmTxSubmodule{}
 
+// EvmTxSubmodule indexes the txs with the accounts found in the EVM logs, along with
+// the EVM specific indices such as the EVM tx hashes, the ERC-20 transfers, the logs
+// and the contracts.
 type EvmTxSubmodule struct {
-	cdc codec.Codec
-
-	sequence              *collections.Sequence
-	txMap                 *collections.Map[string, sdk.TxResponse]
-	txhashesBySequenceMap *collections.Map[uint64, string]
-	txhashesByHeightMap   *collections.Map[collections.Pair[int64, uint64], string]
-	txhashesByAccountMap  *collections.Map[collections.Pair[sdk.AccAddress, uint64], string]
-	accountSequenceMap    *collections.Map[sdk.AccAddress, uint64]
-
-	// for pruning
-	sequenceByHeightMap        *collections.Map[int64, uint64]
-	accountSequenceByHeightMap *collections.Map[collections.Triple[int64, sdk.AccAddress, uint64], bool]
+	txindexer.Indexer
+
+	ac          address.Codec
+	evmTxHasher evmTxHasher
+
+	txHashByEvmTxHashMap        *collections.Map[string, string]
+	evmTxHashByTxHashMap        *collections.Map[string, string]
+	erc20TransfersByAccountMap  *collections.Map[collections.Triple[sdk.AccAddress, uint64, uint32], evm.ERC20Transfer]
+	erc20TransfersByContractMap *collections.Map[collections.Triple[sdk.AccAddress, uint64, uint32], evm.ERC20Transfer]
+	evmLogMap                   *collections.Map[collections.Pair[uint64, uint32], evm.EvmLog]
+	evmLogsByContractMap        *collections.Map[collections.Triple[sdk.AccAddress, uint64, uint32], bool]
+	evmLogsByContractTopicMap   *collections.Map[collections.Triple[sdk.AccAddress, string, collections.Pair[uint64, uint32]], bool]
+	evmLogsByTopicMap           *collections.Map[collections.Triple[string, uint64, uint32], bool]
+	contractMap                 *collections.Map[sdk.AccAddress, evm.Contract]
+	contractsByDeployerMap      *collections.Map[collections.Triple[sdk.AccAddress, uint64, sdk.AccAddress], bool]
+	contractsByTypeMap          *collections.Map[collections.Triple[int32, uint64, sdk.AccAddress], bool]
 }
 
 func NewTxSubmodule(
 	cdc codec.Codec,
 	indexerKeeper collection.IndexerKeeper,
+	vmKeeper *evmkeeper.Keeper,
+	opts ...Option,
 ) (*EvmTxSubmodule, error) {
-	sequencePrefix := collection.NewPrefix(types.SubmoduleName, types.SequencePrefix)
-	sequence, err := collection.AddSequence(indexerKeeper, sequencePrefix, "sequence")
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	prefixTxByEvmTxHash := collection.NewPrefix(types.SubmoduleName, types.TxByEvmTxHashPrefix)
+	txHashByEvmTxHashMap, err := collection.AddMap(indexerKeeper, prefixTxByEvmTxHash, "tx_by_evm_tx_hash", collections.StringKey, collections.StringValue)
 	if err != nil {
 		return nil, err
 	}
 
-	prefixTxs := collection.NewPrefix(types.SubmoduleName, types.TxsPrefix)
-	txMap, err := collection.AddMap(indexerKeeper, prefixTxs, "txs", collections.StringKey, codec.CollValue[sdk.TxResponse](cdc))
+	prefixEvmTxHashByTx := collection.NewPrefix(types.SubmoduleName, types.EvmTxHashByTxPrefix)
+	evmTxHashByTxHashMap, err := collection.AddMap(indexerKeeper, prefixEvmTxHashByTx, "evm_tx_hash_by_tx", collections.StringKey, collections.StringValue)
 	if err != nil {
 		return nil, err
 	}
 
-	prefixTxsByAccount := collection.NewPrefix(types.SubmoduleName, types.TxsByAccountPrefix)
-	txhashesByAccountMap, err := collection.AddMap(indexerKeeper, prefixTxsByAccount, "txs_by_account", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), collections.StringValue)
+	prefixERC20TransfersByAccount := collection.NewPrefix(types.SubmoduleName, types.ERC20TransfersByAccountPrefix)
+	erc20TransfersByAccountMap, err := collection.AddMap(indexerKeeper, prefixERC20TransfersByAccount, "erc20_transfers_by_account", collections.TripleKeyCodec(sdk.AccAddressKey, collections.Uint64Key, collections.Uint32Key), codec.CollValue[evm.ERC20Transfer](cdc))
 	if err != nil {
 		return nil, err
 	}
 
-	prefixTxSequences := collection.NewPrefix(types.SubmoduleName, types.TxSequencePrefix)
-	txhashesBySequenceMap, err := collection.AddMap(indexerKeeper, prefixTxSequences, "tx_sequences", collections.Uint64Key, collections.StringValue)
+	prefixERC20TransfersByContract := collection.NewPrefix(types.SubmoduleName, types.ERC20TransfersByContractPrefix)
+	erc20TransfersByContractMap, err := collection.AddMap(indexerKeeper, prefixERC20TransfersByContract, "erc20_transfers_by_contract", collections.TripleKeyCodec(sdk.AccAddressKey, collections.Uint64Key, collections.Uint32Key), codec.CollValue[evm.ERC20Transfer](cdc))
 	if err != nil {
 		return nil, err
 	}
 
-	prefixTxsByHeight := collection.NewPrefix(types.SubmoduleName, types.TxByHeightPrefix)
-	txhashesByHeightMap, err := collection.AddMap(indexerKeeper, prefixTxsByHeight, "txs_by_height", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key), collections.StringValue)
+	prefixEvmLogs := collection.NewPrefix(types.SubmoduleName, types.EvmLogsPrefix)
+	evmLogMap, err := collection.AddMap(indexerKeeper, prefixEvmLogs, "evm_logs", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[evm.EvmLog](cdc))
 	if err != nil {
 		return nil, err
 	}
 
-	prefixAccountSequences := collection.NewPrefix(types.SubmoduleName, types.AccountSequencePrefix)
-	accountSequenceMap, err := collection.AddMap(indexerKeeper, prefixAccountSequences, "account_sequences", sdk.AccAddressKey, collections.Uint64Value)
+	prefixEvmLogsByContract := collection.NewPrefix(types.SubmoduleName, types.EvmLogsByContractPrefix)
+	evmLogsByContractMap, err := collection.AddMap(indexerKeeper, prefixEvmLogsByContract, "evm_logs_by_contract", collections.TripleKeyCodec(sdk.AccAddressKey, collections.Uint64Key, collections.Uint32Key), collections.BoolValue)
 	if err != nil {
 		return nil, err
 	}
 
-	prefixSequenceByHeight := collection.NewPrefix(types.SubmoduleName, types.SequenceByHeightPrefix)
-	sequenceByHeightMap, err := collection.AddMap(indexerKeeper, prefixSequenceByHeight, "sequence_by_height", collections.Int64Key, collections.Uint64Value)
+	prefixEvmLogsByContractTopic := collection.NewPrefix(types.SubmoduleName, types.EvmLogsByContractTopicPrefix)
+	evmLogsByContractTopicMap, err := collection.AddMap(indexerKeeper, prefixEvmLogsByContractTopic, "evm_logs_by_contract_topic", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key)), collections.BoolValue)
 	if err != nil {
 		return nil, err
 	}
 
-	prefixAccountSequenceByHeight := collection.NewPrefix(types.SubmoduleName, types.AccountSequenceByHeightPrefix)
-	accountSequenceByHeightMap, err := collection.AddMap(indexerKeeper, prefixAccountSequenceByHeight, "account_sequence_by_height", collections.TripleKeyCodec(collections.Int64Key, sdk.AccAddressKey, collections.Uint64Key), collections.BoolValue)
+	prefixEvmLogsByTopic := collection.NewPrefix(types.SubmoduleName, types.EvmLogsByTopicPrefix)
+	evmLogsByTopicMap, err := collection.AddMap(indexerKeeper, prefixEvmLogsByTopic, "evm_logs_by_topic", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint32Key), collections.BoolValue)
 	if err != nil {
 		return nil, err
 	}
 
-	return &EvmTxSubmodule{
-		cdc: cdc,
-
-		sequence:                   sequence,
-		txMap:                      txMap,
-		txhashesByAccountMap:       txhashesByAccountMap,
-		txhashesBySequenceMap:      txhashesBySequenceMap,
-		txhashesByHeightMap:        txhashesByHeightMap,
-		accountSequenceMap:         accountSequenceMap,
-		sequenceByHeightMap:        sequenceByHeightMap,
-		accountSequenceByHeightMap: accountSequenceByHeightMap,
-	}, nil
-}
-
-// Logger returns a module-specific logger.
-func (sub EvmTxSubmodule) Logger(ctx context.Context) log.Logger {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	return sdkCtx.Logger().With("module", "x/"+types.SubmoduleName)
-}
-
-func (sub EvmTxSubmodule) Name() string {
-	return types.SubmoduleName
-}
-
-func (sub EvmTxSubmodule) Version() string {
-	return types.Version
-}
+	prefixContracts := collection.NewPrefix(types.SubmoduleName, types.ContractsPrefix)
+	contractMap, err := collection.AddMap(indexerKeeper, prefixContracts, "contracts", sdk.AccAddressKey, codec.CollValue[evm.Contract](cdc))
+	if err != nil {
+		return nil, err
+	}
 
-func (sub EvmTxSubmodule) RegisterQueryHandlerClient(cc client.Context, mux *runtime.ServeMux) error {
-	return types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(cc))
-}
+	prefixContractsByDeployer := collection.NewPrefix(types.SubmoduleName, types.ContractsByDeployerPrefix)
+	contractsByDeployerMap, err := collection.AddMap(indexerKeeper, prefixContractsByDeployer, "contracts_by_deployer", collections.TripleKeyCodec(sdk.AccAddressKey, collections.Uint64Key, sdk.AccAddressKey), collections.BoolValue)
+	if err != nil {
+		return nil, err
+	}
 
-func (sub EvmTxSubmodule) RegisterQueryServer(s grpc.Server) {
-	types.RegisterQueryServer(s, NewQuerier(sub))
-}
+	prefixContractsByType := collection.NewPrefix(types.SubmoduleName, types.ContractsByTypePrefix)
+	contractsByTypeMap, err := collection.AddMap(indexerKeeper, prefixContractsByType, "contracts_by_type", collections.TripleKeyCodec(collections.Int32Key, collections.Uint64Key, sdk.AccAddressKey), collections.BoolValue)
+	if err != nil {
+		return nil, err
+	}
 
-func (sub EvmTxSubmodule) Prepare(ctx context.Context) error {
-	return nil
-}
+	sub := &EvmTxSubmodule{
+		ac:          indexerKeeper.GetAddressCodec(),
+		evmTxHasher: newEvmTxHasher(vmKeeper, authtx.DefaultTxDecoder(cdc)),
+
+		txHashByEvmTxHashMap:        txHashByEvmTxHashMap,
+		evmTxHashByTxHashMap:        evmTxHashByTxHashMap,
+		erc20TransfersByAccountMap:  erc20TransfersByAccountMap,
+		erc20TransfersByContractMap: erc20TransfersByContractMap,
+		evmLogMap:                   evmLogMap,
+		evmLogsByContractMap:        evmLogsByContractMap,
+		evmLogsByContractTopicMap:   evmLogsByContractTopicMap,
+		evmLogsByTopicMap:           evmLogsByTopicMap,
+		contractMap:                 contractMap,
+		contractsByDeployerMap:      contractsByDeployerMap,
+		contractsByTypeMap:          contractsByTypeMap,
+	}
 
-func (sub EvmTxSubmodule) Initialize(ctx context.Context) error {
-	return nil
-}
+	// the hooks only use the EVM specific indices, so they are set before the indexer is created
+	indexerOpts := append(o.indexerOpts, txindexer.WithIndexHook(sub))
+	indexer, err := txindexer.NewIndexer(types.SubmoduleName, types.Version, cdc, indexerKeeper, evmAccountExtractor{sub.ac}, indexerOpts...)
+	if err != nil {
+		return nil, err
+	}
+	sub.Indexer = *indexer
 
-func (sub EvmTxSubmodule) FinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
-	return sub.finalizeBlock(ctx, req, res)
+	return sub, nil
 }
 
-func (sub EvmTxSubmodule) Commit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
-	return nil
+func (sub EvmTxSubmodule) RegisterQueryHandlerClient(cc client.Context, mux *runtime.ServeMux) error {
+	if err := sub.Indexer.RegisterQueryHandlerClient(cc, mux); err != nil {
+		return err
+	}
+	return evm.RegisterQueryHandlerClient(context.Background(), mux, evm.NewQueryClient(cc))
 }
 
-func (sub EvmTxSubmodule) Prune(ctx context.Context, minHeight int64) error {
-	return sub.prune(ctx, minHeight)
+func (sub EvmTxSubmodule) RegisterQueryServer(s grpc.Server) {
+	sub.Indexer.RegisterQueryServer(s)
+	evm.RegisterQueryServer(s, NewEvmQuerier(sub))
 }
@@ -0,0 +1,4638 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: indexer/evm/v1/query.proto
+
+package evm
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/initia-labs/kvindexer/tx/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryTxByEvmHashRequest is the request type for the Query/TxByEvmHash RPC
+// method
+type QueryTxByEvmHashRequest struct {
+	// evm_tx_hash is a hex string of the EVM tx hash with or without 0x prefix.
+	EvmTxHash string `protobuf:"bytes,1,opt,name=evm_tx_hash,json=evmTxHash,proto3" json:"evm_tx_hash,omitempty"`
+}
+
+func (m *QueryTxByEvmHashRequest) Reset()         { *m = QueryTxByEvmHashRequest{} }
+func (m *QueryTxByEvmHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxByEvmHashRequest) ProtoMessage()    {}
+func (*QueryTxByEvmHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{0}
+}
+func (m *QueryTxByEvmHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxByEvmHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxByEvmHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxByEvmHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxByEvmHashRequest.Merge(m, src)
+}
+func (m *QueryTxByEvmHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxByEvmHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxByEvmHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxByEvmHashRequest proto.InternalMessageInfo
+
+func (m *QueryTxByEvmHashRequest) GetEvmTxHash() string {
+	if m != nil {
+		return m.EvmTxHash
+	}
+	return ""
+}
+
+// QueryTxByEvmHashResponse is the response type for the Query/TxByEvmHash RPC
+// method
+type QueryTxByEvmHashResponse struct {
+	Tx *types.TxResponse `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// evm_tx_hash is the 0x prefixed lowercase hex EVM tx hash of the
+	// transaction.
+	EvmTxHash string `protobuf:"bytes,2,opt,name=evm_tx_hash,json=evmTxHash,proto3" json:"evm_tx_hash,omitempty"`
+}
+
+func (m *QueryTxByEvmHashResponse) Reset()         { *m = QueryTxByEvmHashResponse{} }
+func (m *QueryTxByEvmHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxByEvmHashResponse) ProtoMessage()    {}
+func (*QueryTxByEvmHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{1}
+}
+func (m *QueryTxByEvmHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxByEvmHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxByEvmHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxByEvmHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxByEvmHashResponse.Merge(m, src)
+}
+func (m *QueryTxByEvmHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxByEvmHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxByEvmHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxByEvmHashResponse proto.InternalMessageInfo
+
+func (m *QueryTxByEvmHashResponse) GetTx() *types.TxResponse {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *QueryTxByEvmHashResponse) GetEvmTxHash() string {
+	if m != nil {
+		return m.EvmTxHash
+	}
+	return ""
+}
+
+// QueryEvmTxHashesRequest is the request type for the Query/EvmTxHashes RPC
+// method
+type QueryEvmTxHashesRequest struct {
+	// tx_hashes are the hashes of the transactions. Up to 100 hashes are allowed.
+	TxHashes []string `protobuf:"bytes,1,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+}
+
+func (m *QueryEvmTxHashesRequest) Reset()         { *m = QueryEvmTxHashesRequest{} }
+func (m *QueryEvmTxHashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmTxHashesRequest) ProtoMessage()    {}
+func (*QueryEvmTxHashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{2}
+}
+func (m *QueryEvmTxHashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmTxHashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmTxHashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvmTxHashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmTxHashesRequest.Merge(m, src)
+}
+func (m *QueryEvmTxHashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvmTxHashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmTxHashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvmTxHashesRequest proto.InternalMessageInfo
+
+func (m *QueryEvmTxHashesRequest) GetTxHashes() []string {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+// QueryEvmTxHashesResponse is the response type for the Query/EvmTxHashes RPC
+// method
+type QueryEvmTxHashesResponse struct {
+	// evm_tx_hashes maps the lowercase hashes of the transactions to their EVM
+	// tx hashes. The transactions which are not EVM txs are omitted.
+	EvmTxHashes map[string]string `protobuf:"bytes,1,rep,name=evm_tx_hashes,json=evmTxHashes,proto3" json:"evm_tx_hashes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *QueryEvmTxHashesResponse) Reset()         { *m = QueryEvmTxHashesResponse{} }
+func (m *QueryEvmTxHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmTxHashesResponse) ProtoMessage()    {}
+func (*QueryEvmTxHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{3}
+}
+func (m *QueryEvmTxHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmTxHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmTxHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvmTxHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmTxHashesResponse.Merge(m, src)
+}
+func (m *QueryEvmTxHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvmTxHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmTxHashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvmTxHashesResponse proto.InternalMessageInfo
+
+func (m *QueryEvmTxHashesResponse) GetEvmTxHashes() map[string]string {
+	if m != nil {
+		return m.EvmTxHashes
+	}
+	return nil
+}
+
+// QueryEvmTxsResponse is the response type for the Query/Txs,
+// Query/TxsByAccount and Query/TxsByHeight RPC methods
+type QueryEvmTxsResponse struct {
+	Txs        []EvmTx             `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEvmTxsResponse) Reset()         { *m = QueryEvmTxsResponse{} }
+func (m *QueryEvmTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmTxsResponse) ProtoMessage()    {}
+func (*QueryEvmTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{4}
+}
+func (m *QueryEvmTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvmTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmTxsResponse.Merge(m, src)
+}
+func (m *QueryEvmTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvmTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvmTxsResponse proto.InternalMessageInfo
+
+func (m *QueryEvmTxsResponse) GetTxs() []EvmTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *QueryEvmTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// EvmTx defines a transaction with its EVM tx hash
+type EvmTx struct {
+	Tx *types.TxResponse `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// evm_tx_hash is the 0x prefixed lowercase hex EVM tx hash of the
+	// transaction. It is empty if the transaction is not an EVM tx.
+	EvmTxHash string `protobuf:"bytes,2,opt,name=evm_tx_hash,json=evmTxHash,proto3" json:"evm_tx_hash,omitempty"`
+}
+
+func (m *EvmTx) Reset()         { *m = EvmTx{} }
+func (m *EvmTx) String() string { return proto.CompactTextString(m) }
+func (*EvmTx) ProtoMessage()    {}
+func (*EvmTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{5}
+}
+func (m *EvmTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTx.Merge(m, src)
+}
+func (m *EvmTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTx proto.InternalMessageInfo
+
+func (m *EvmTx) GetTx() *types.TxResponse {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *EvmTx) GetEvmTxHash() string {
+	if m != nil {
+		return m.EvmTxHash
+	}
+	return ""
+}
+
+// QueryERC20TransfersByAccountRequest is the request type for the
+// Query/ERC20TransfersByAccount RPC method
+type QueryERC20TransfersByAccountRequest struct {
+	// account is the bech32 or hex address of the account
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// contract filters the transfers by the token contract if set
+	Contract   string             `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryERC20TransfersByAccountRequest) Reset()         { *m = QueryERC20TransfersByAccountRequest{} }
+func (m *QueryERC20TransfersByAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20TransfersByAccountRequest) ProtoMessage()    {}
+func (*QueryERC20TransfersByAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{6}
+}
+func (m *QueryERC20TransfersByAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20TransfersByAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20TransfersByAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryERC20TransfersByAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20TransfersByAccountRequest.Merge(m, src)
+}
+func (m *QueryERC20TransfersByAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20TransfersByAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20TransfersByAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20TransfersByAccountRequest proto.InternalMessageInfo
+
+func (m *QueryERC20TransfersByAccountRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryERC20TransfersByAccountRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryERC20TransfersByAccountRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryERC20TransfersByContractRequest is the request type for the
+// Query/ERC20TransfersByContract RPC method
+type QueryERC20TransfersByContractRequest struct {
+	// contract is the bech32 or hex address of the token contract
+	Contract   string             `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryERC20TransfersByContractRequest) Reset()         { *m = QueryERC20TransfersByContractRequest{} }
+func (m *QueryERC20TransfersByContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20TransfersByContractRequest) ProtoMessage()    {}
+func (*QueryERC20TransfersByContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{7}
+}
+func (m *QueryERC20TransfersByContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20TransfersByContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20TransfersByContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryERC20TransfersByContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20TransfersByContractRequest.Merge(m, src)
+}
+func (m *QueryERC20TransfersByContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20TransfersByContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20TransfersByContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20TransfersByContractRequest proto.InternalMessageInfo
+
+func (m *QueryERC20TransfersByContractRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryERC20TransfersByContractRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryERC20TransfersResponse is the response type for the
+// Query/ERC20TransfersByAccount and Query/ERC20TransfersByContract RPC
+// methods
+type QueryERC20TransfersResponse struct {
+	Transfers  []ERC20Transfer     `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryERC20TransfersResponse) Reset()         { *m = QueryERC20TransfersResponse{} }
+func (m *QueryERC20TransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20TransfersResponse) ProtoMessage()    {}
+func (*QueryERC20TransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{8}
+}
+func (m *QueryERC20TransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20TransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20TransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryERC20TransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20TransfersResponse.Merge(m, src)
+}
+func (m *QueryERC20TransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20TransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20TransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20TransfersResponse proto.InternalMessageInfo
+
+func (m *QueryERC20TransfersResponse) GetTransfers() []ERC20Transfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *QueryERC20TransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEvmLogsRequest is the request type for the Query/EvmLogs RPC method
+type QueryEvmLogsRequest struct {
+	// addresses are the bech32 or hex addresses of the contracts emitted the
+	// logs. Any contract matches if empty.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// topics are the filters of the topics at each position. Up to 4 positions
+	// are allowed.
+	Topics []TopicFilter `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics"`
+	// from_height and to_height are the inclusive height range of the logs.
+	// Zero means unbounded. Both are required within 10000 heights unless the
+	// filter has a single address or a single topic at the first position, or
+	// has nothing to match.
+	FromHeight int64              `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64              `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEvmLogsRequest) Reset()         { *m = QueryEvmLogsRequest{} }
+func (m *QueryEvmLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmLogsRequest) ProtoMessage()    {}
+func (*QueryEvmLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{9}
+}
+func (m *QueryEvmLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvmLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmLogsRequest.Merge(m, src)
+}
+func (m *QueryEvmLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvmLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvmLogsRequest proto.InternalMessageInfo
+
+func (m *QueryEvmLogsRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryEvmLogsRequest) GetTopics() []TopicFilter {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *QueryEvmLogsRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryEvmLogsRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryEvmLogsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// TopicFilter defines the topics allowed at a position. Any topic matches if
+// empty.
+type TopicFilter struct {
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *TopicFilter) Reset()         { *m = TopicFilter{} }
+func (m *TopicFilter) String() string { return proto.CompactTextString(m) }
+func (*TopicFilter) ProtoMessage()    {}
+func (*TopicFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{10}
+}
+func (m *TopicFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicFilter.Merge(m, src)
+}
+func (m *TopicFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicFilter proto.InternalMessageInfo
+
+func (m *TopicFilter) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// QueryEvmLogsResponse is the response type for the Query/EvmLogs RPC method
+type QueryEvmLogsResponse struct {
+	Logs       []EvmLog            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEvmLogsResponse) Reset()         { *m = QueryEvmLogsResponse{} }
+func (m *QueryEvmLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmLogsResponse) ProtoMessage()    {}
+func (*QueryEvmLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{11}
+}
+func (m *QueryEvmLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvmLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmLogsResponse.Merge(m, src)
+}
+func (m *QueryEvmLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvmLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvmLogsResponse proto.InternalMessageInfo
+
+func (m *QueryEvmLogsResponse) GetLogs() []EvmLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *QueryEvmLogsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryContractRequest is the request type for the Query/Contract RPC method
+type QueryContractRequest struct {
+	// address is the bech32 or hex address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryContractRequest) Reset()         { *m = QueryContractRequest{} }
+func (m *QueryContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractRequest) ProtoMessage()    {}
+func (*QueryContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{12}
+}
+func (m *QueryContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractRequest.Merge(m, src)
+}
+func (m *QueryContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractRequest proto.InternalMessageInfo
+
+func (m *QueryContractRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryContractResponse is the response type for the Query/Contract RPC method
+type QueryContractResponse struct {
+	Contract *Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryContractResponse) Reset()         { *m = QueryContractResponse{} }
+func (m *QueryContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractResponse) ProtoMessage()    {}
+func (*QueryContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{13}
+}
+func (m *QueryContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractResponse.Merge(m, src)
+}
+func (m *QueryContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractResponse proto.InternalMessageInfo
+
+func (m *QueryContractResponse) GetContract() *Contract {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+// QueryContractsByDeployerRequest is the request type for the
+// Query/ContractsByDeployer RPC method
+type QueryContractsByDeployerRequest struct {
+	// deployer is the bech32 or hex address of the deployer
+	Deployer   string             `protobuf:"bytes,1,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractsByDeployerRequest) Reset()         { *m = QueryContractsByDeployerRequest{} }
+func (m *QueryContractsByDeployerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByDeployerRequest) ProtoMessage()    {}
+func (*QueryContractsByDeployerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{14}
+}
+func (m *QueryContractsByDeployerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByDeployerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByDeployerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractsByDeployerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByDeployerRequest.Merge(m, src)
+}
+func (m *QueryContractsByDeployerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByDeployerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByDeployerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsByDeployerRequest proto.InternalMessageInfo
+
+func (m *QueryContractsByDeployerRequest) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
+func (m *QueryContractsByDeployerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryContractsByTypeRequest is the request type for the
+// Query/ContractsByType RPC method
+type QueryContractsByTypeRequest struct {
+	// type must be either ERC-20 or ERC-721
+	Type       ContractType       `protobuf:"varint,1,opt,name=type,proto3,enum=indexer.evm.v1.ContractType" json:"type,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractsByTypeRequest) Reset()         { *m = QueryContractsByTypeRequest{} }
+func (m *QueryContractsByTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByTypeRequest) ProtoMessage()    {}
+func (*QueryContractsByTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{15}
+}
+func (m *QueryContractsByTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractsByTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByTypeRequest.Merge(m, src)
+}
+func (m *QueryContractsByTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsByTypeRequest proto.InternalMessageInfo
+
+func (m *QueryContractsByTypeRequest) GetType() ContractType {
+	if m != nil {
+		return m.Type
+	}
+	return ContractTypeUnspecified
+}
+
+func (m *QueryContractsByTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryContractsResponse is the response type for the
+// Query/ContractsByDeployer and Query/ContractsByType RPC methods
+type QueryContractsResponse struct {
+	Contracts  []Contract          `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractsResponse) Reset()         { *m = QueryContractsResponse{} }
+func (m *QueryContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsResponse) ProtoMessage()    {}
+func (*QueryContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc818e2ede337016, []int{16}
+}
+func (m *QueryContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsResponse.Merge(m, src)
+}
+func (m *QueryContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsResponse proto.InternalMessageInfo
+
+func (m *QueryContractsResponse) GetContracts() []Contract {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *QueryContractsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryTxByEvmHashRequest)(nil), "indexer.evm.v1.QueryTxByEvmHashRequest")
+	proto.RegisterType((*QueryTxByEvmHashResponse)(nil), "indexer.evm.v1.QueryTxByEvmHashResponse")
+	proto.RegisterType((*QueryEvmTxHashesRequest)(nil), "indexer.evm.v1.QueryEvmTxHashesRequest")
+	proto.RegisterType((*QueryEvmTxHashesResponse)(nil), "indexer.evm.v1.QueryEvmTxHashesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "indexer.evm.v1.QueryEvmTxHashesResponse.EvmTxHashesEntry")
+	proto.RegisterType((*QueryEvmTxsResponse)(nil), "indexer.evm.v1.QueryEvmTxsResponse")
+	proto.RegisterType((*EvmTx)(nil), "indexer.evm.v1.EvmTx")
+	proto.RegisterType((*QueryERC20TransfersByAccountRequest)(nil), "indexer.evm.v1.QueryERC20TransfersByAccountRequest")
+	proto.RegisterType((*QueryERC20TransfersByContractRequest)(nil), "indexer.evm.v1.QueryERC20TransfersByContractRequest")
+	proto.RegisterType((*QueryERC20TransfersResponse)(nil), "indexer.evm.v1.QueryERC20TransfersResponse")
+	proto.RegisterType((*QueryEvmLogsRequest)(nil), "indexer.evm.v1.QueryEvmLogsRequest")
+	proto.RegisterType((*TopicFilter)(nil), "indexer.evm.v1.TopicFilter")
+	proto.RegisterType((*QueryEvmLogsResponse)(nil), "indexer.evm.v1.QueryEvmLogsResponse")
+	proto.RegisterType((*QueryContractRequest)(nil), "indexer.evm.v1.QueryContractRequest")
+	proto.RegisterType((*QueryContractResponse)(nil), "indexer.evm.v1.QueryContractResponse")
+	proto.RegisterType((*QueryContractsByDeployerRequest)(nil), "indexer.evm.v1.QueryContractsByDeployerRequest")
+	proto.RegisterType((*QueryContractsByTypeRequest)(nil), "indexer.evm.v1.QueryContractsByTypeRequest")
+	proto.RegisterType((*QueryContractsResponse)(nil), "indexer.evm.v1.QueryContractsResponse")
+}
+
+func init() { proto.RegisterFile("indexer/evm/v1/query.proto", fileDescriptor_fc818e2ede337016) }
+
+var fileDescriptor_fc818e2ede337016 = []byte{
+	// 1231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x4f, 0x6b, 0x1b, 0x47,
+	0x14, 0xc0, 0x3d, 0x52, 0x9c, 0x58, 0x4f, 0x69, 0x1a, 0x26, 0x8e, 0xa3, 0xc8, 0x8e, 0x1c, 0xe4,
+	0x7f, 0xc2, 0xc6, 0xbb, 0xb2, 0xe2, 0x1a, 0x3b, 0x98, 0x40, 0x94, 0x3a, 0xcd, 0x21, 0x85, 0x56,
+	0x88, 0x1e, 0x0a, 0x41, 0xac, 0xe4, 0xc9, 0x6a, 0x89, 0xa4, 0x51, 0x34, 0x23, 0xb1, 0x8b, 0xd0,
+	0xa5, 0xb4, 0x14, 0x02, 0x2d, 0x2d, 0x3d, 0xa4, 0xb7, 0x1e, 0x7a, 0x69, 0x3f, 0x42, 0x0e, 0xbd,
+	0xe7, 0x18, 0xe8, 0xa5, 0xa7, 0x52, 0xec, 0x7e, 0x82, 0xd2, 0x0f, 0x50, 0x76, 0x76, 0x66, 0xb5,
+	0x5a, 0x69, 0x6d, 0x27, 0xb8, 0x17, 0x7b, 0xfe, 0xbc, 0x37, 0xef, 0x37, 0xef, 0xcf, 0xbe, 0x11,
+	0xa4, 0xad, 0xd6, 0x21, 0xb1, 0x49, 0x47, 0x27, 0xbd, 0xa6, 0xde, 0xdb, 0xd2, 0x9f, 0x77, 0x49,
+	0xc7, 0xd1, 0xda, 0x1d, 0xca, 0x29, 0xbe, 0x22, 0xf7, 0x34, 0xd2, 0x6b, 0x6a, 0xbd, 0xad, 0xf4,
+	0x52, 0x8d, 0xb2, 0x26, 0x65, 0x7a, 0xd5, 0x60, 0x44, 0x37, 0xaa, 0x35, 0x4b, 0xef, 0x6d, 0x55,
+	0x09, 0x37, 0xb6, 0xc4, 0xc4, 0x53, 0x4a, 0xaf, 0x07, 0x85, 0xc4, 0x69, 0xbe, 0x54, 0xdb, 0x30,
+	0xad, 0x96, 0xc1, 0x2d, 0xda, 0x92, 0xb2, 0xb3, 0x26, 0x35, 0xa9, 0x18, 0xea, 0xee, 0x48, 0xae,
+	0x2e, 0x98, 0x94, 0x9a, 0x0d, 0xa2, 0x1b, 0x6d, 0x4b, 0x37, 0x5a, 0x2d, 0xca, 0x85, 0x0a, 0x93,
+	0xbb, 0x61, 0x60, 0xee, 0xb4, 0x89, 0xda, 0xbb, 0xa9, 0xf6, 0xb8, 0x1d, 0xba, 0x4b, 0x76, 0x0f,
+	0x6e, 0x7c, 0xea, 0x4e, 0xcb, 0x76, 0xd1, 0x39, 0xe8, 0x35, 0x1f, 0x19, 0xac, 0x5e, 0x22, 0xcf,
+	0xbb, 0x84, 0x71, 0x9c, 0x81, 0x24, 0xe9, 0x35, 0x2b, 0xdc, 0xae, 0xd4, 0x0d, 0x56, 0x4f, 0xa1,
+	0xdb, 0x28, 0x97, 0x28, 0x25, 0x48, 0xaf, 0x59, 0xb6, 0x5d, 0xb1, 0x6c, 0x1b, 0x52, 0xe3, 0xaa,
+	0xac, 0x4d, 0x5b, 0x8c, 0xe0, 0x6d, 0x88, 0x71, 0x5b, 0xa8, 0x24, 0x0b, 0xcb, 0x9a, 0x77, 0x75,
+	0xcd, 0xbd, 0xba, 0x26, 0x5c, 0x22, 0x6f, 0xae, 0x95, 0x6d, 0xa5, 0x51, 0x8a, 0x71, 0x3b, 0x6c,
+	0x31, 0x16, 0xb6, 0xb8, 0x23, 0x61, 0x0f, 0xd4, 0x0a, 0x61, 0x0a, 0x76, 0x1e, 0x12, 0x52, 0x8d,
+	0xb0, 0x14, 0xba, 0x1d, 0xcf, 0x25, 0x4a, 0x33, 0x5c, 0xca, 0x64, 0x5f, 0x21, 0x48, 0x8d, 0x2b,
+	0x4a, 0xd4, 0x27, 0xf0, 0x5e, 0xc0, 0xa8, 0xd4, 0x4e, 0x16, 0xf6, 0xb4, 0xd1, 0x28, 0x6b, 0x51,
+	0x07, 0x68, 0x81, 0xb5, 0x83, 0x16, 0xef, 0x38, 0xa5, 0x24, 0x19, 0xae, 0xa4, 0xef, 0xc1, 0xd5,
+	0xb0, 0x00, 0xbe, 0x0a, 0xf1, 0x67, 0xc4, 0x91, 0x1e, 0x75, 0x87, 0x78, 0x16, 0xa6, 0x7b, 0x46,
+	0xa3, 0x4b, 0xe4, 0x9d, 0xbd, 0xc9, 0xdd, 0xd8, 0x2e, 0xca, 0x7e, 0x83, 0xe0, 0xda, 0xd0, 0xf4,
+	0x10, 0x7b, 0x13, 0xe2, 0xdc, 0x56, 0xb0, 0xd7, 0xc3, 0xb0, 0x42, 0xb8, 0x78, 0xe1, 0xf5, 0x9f,
+	0x8b, 0x53, 0x25, 0x57, 0x0e, 0x7f, 0x04, 0x30, 0x4c, 0x33, 0x61, 0x25, 0x59, 0x58, 0x1b, 0x09,
+	0x8c, 0x97, 0x15, 0x2a, 0x32, 0x9f, 0x18, 0x26, 0xf1, 0x63, 0x13, 0x50, 0xcd, 0x3e, 0x81, 0x69,
+	0x71, 0xf8, 0xff, 0x14, 0xe2, 0x9f, 0x11, 0x2c, 0x79, 0xd7, 0x2d, 0x3d, 0x28, 0xe4, 0xcb, 0x1d,
+	0xa3, 0xc5, 0x9e, 0x92, 0x0e, 0x2b, 0x3a, 0xf7, 0x6b, 0x35, 0xda, 0x6d, 0x71, 0x15, 0xef, 0x14,
+	0x5c, 0x32, 0xbc, 0x15, 0xe9, 0x46, 0x35, 0xc5, 0x69, 0x98, 0xa9, 0xd1, 0x16, 0xef, 0x18, 0x35,
+	0x2e, 0x8f, 0xf7, 0xe7, 0xf8, 0xe1, 0x88, 0x17, 0xe2, 0x82, 0x7d, 0xf5, 0x54, 0x2f, 0x08, 0x8b,
+	0x23, 0x4e, 0x78, 0x81, 0x60, 0x79, 0x22, 0xe5, 0x03, 0x69, 0x49, 0x61, 0x06, 0x61, 0xd0, 0x89,
+	0x30, 0xb1, 0x77, 0x86, 0xf9, 0x15, 0xc1, 0xfc, 0x04, 0x18, 0x3f, 0x53, 0xee, 0x43, 0x82, 0xab,
+	0x45, 0x99, 0x2f, 0xb7, 0xc6, 0xf2, 0x25, 0xa8, 0x2a, 0xf3, 0x66, 0xa8, 0x75, 0x7e, 0xd9, 0xf3,
+	0x6f, 0x20, 0x9b, 0x1f, 0x53, 0xd3, 0x2f, 0xdf, 0x05, 0x48, 0x18, 0x87, 0x87, 0x1d, 0xc2, 0x98,
+	0x5f, 0xbe, 0xc3, 0x05, 0xbc, 0x07, 0x17, 0x39, 0x6d, 0x5b, 0x35, 0x96, 0x8a, 0x09, 0xfc, 0xf9,
+	0x30, 0x7e, 0xd9, 0xdd, 0x7d, 0x68, 0x35, 0xb8, 0x0f, 0x2f, 0x15, 0xf0, 0x22, 0x24, 0x9f, 0x76,
+	0x68, 0xb3, 0x52, 0x27, 0x96, 0x59, 0xe7, 0x22, 0xe4, 0xf1, 0x12, 0xb8, 0x4b, 0x8f, 0xc4, 0x8a,
+	0xf8, 0x70, 0x50, 0xb5, 0x7d, 0x41, 0x6c, 0xcf, 0x70, 0x2a, 0x37, 0x47, 0x43, 0x34, 0xfd, 0xce,
+	0x21, 0x5a, 0x81, 0x64, 0x00, 0x11, 0xcf, 0xc1, 0x45, 0x51, 0xe0, 0xea, 0xaa, 0x72, 0x96, 0xfd,
+	0x1e, 0xc1, 0xec, 0xa8, 0x77, 0x64, 0x08, 0xf3, 0x70, 0xa1, 0x41, 0x4d, 0x15, 0xbd, 0xb9, 0x09,
+	0xd5, 0xfe, 0x98, 0x9a, 0xf2, 0xe6, 0x42, 0xf2, 0xfc, 0x22, 0x96, 0x97, 0x48, 0xe1, 0xcc, 0x76,
+	0x0b, 0xd0, 0x0b, 0x90, 0x5f, 0x80, 0xde, 0x34, 0xfb, 0x31, 0x5c, 0x0f, 0x69, 0xf8, 0x4d, 0x61,
+	0xb4, 0x18, 0x92, 0x85, 0x54, 0xf8, 0x26, 0xbe, 0x8e, 0x2f, 0x99, 0xfd, 0x0a, 0xc1, 0xe2, 0xc8,
+	0x79, 0xac, 0xe8, 0x7c, 0x48, 0xda, 0x0d, 0xea, 0x90, 0x4e, 0xa0, 0xcc, 0x0e, 0xe5, 0x92, 0x2a,
+	0x33, 0x35, 0x3f, 0xb7, 0x32, 0x7b, 0xa9, 0xca, 0x2c, 0xc0, 0x51, 0x76, 0xda, 0x4a, 0xd6, 0x8d,
+	0x91, 0xdb, 0x73, 0x85, 0xfd, 0x2b, 0x85, 0x85, 0xa8, 0x9b, 0x09, 0x15, 0x21, 0x79, 0x6e, 0x64,
+	0x3f, 0x21, 0x98, 0x1b, 0x25, 0xf3, 0x5d, 0xbe, 0x0f, 0x09, 0xe5, 0x48, 0x95, 0x3d, 0x91, 0x3e,
+	0x57, 0x65, 0xef, 0x2b, 0x9c, 0x5b, 0x12, 0x15, 0xfe, 0xb9, 0x0c, 0xd3, 0x82, 0x10, 0xbf, 0x44,
+	0x90, 0x0c, 0x3c, 0x18, 0xf0, 0xda, 0xc4, 0x36, 0x3b, 0xfe, 0x1a, 0x49, 0xe7, 0x4e, 0x17, 0xf4,
+	0x0c, 0x67, 0x3f, 0xf8, 0xe2, 0xf7, 0xbf, 0x7f, 0x88, 0xe9, 0x78, 0x53, 0x0f, 0x3f, 0x89, 0x6c,
+	0xa6, 0x57, 0x9d, 0x8a, 0xdb, 0x7f, 0xdc, 0xe6, 0xa3, 0xf7, 0x03, 0x9d, 0x68, 0x80, 0xbf, 0x46,
+	0x90, 0x0c, 0x74, 0xea, 0x08, 0xb2, 0xf1, 0xa7, 0x47, 0x3a, 0x77, 0xba, 0xa0, 0x24, 0x5b, 0x11,
+	0x64, 0x8b, 0xf8, 0x56, 0x98, 0x6c, 0xe4, 0x01, 0x82, 0x4d, 0x88, 0x97, 0x6d, 0x86, 0x33, 0xfe,
+	0xb9, 0xdc, 0x0e, 0x5c, 0xd8, 0xb7, 0xbb, 0x14, 0x6d, 0x77, 0x68, 0x72, 0x5e, 0x98, 0xbc, 0x8e,
+	0xaf, 0x4d, 0x70, 0x06, 0xfe, 0x16, 0xc1, 0xe5, 0xb2, 0x3d, 0x6c, 0xae, 0x78, 0x2d, 0xc2, 0x64,
+	0xb8, 0xfd, 0x9e, 0xcd, 0x76, 0x5e, 0xd8, 0x5e, 0xc7, 0xb9, 0x88, 0x40, 0xc8, 0x8e, 0xad, 0xf7,
+	0xe5, 0x60, 0x80, 0x5f, 0x88, 0xec, 0x60, 0x45, 0x47, 0x7e, 0x7f, 0x57, 0x23, 0x79, 0x3c, 0x81,
+	0xb7, 0xc2, 0xd1, 0x04, 0x4e, 0x0e, 0xaf, 0x46, 0xe0, 0x78, 0x3d, 0x40, 0xef, 0x7b, 0xff, 0x07,
+	0xf8, 0x15, 0x82, 0x1b, 0x11, 0xaf, 0x10, 0x7c, 0x67, 0xb2, 0xc1, 0x13, 0xdf, 0x2c, 0xe9, 0x8d,
+	0x33, 0x28, 0xf9, 0xb4, 0xfb, 0x82, 0x76, 0x07, 0x6f, 0x8f, 0xe5, 0x4a, 0xa7, 0x56, 0xc8, 0x57,
+	0xfc, 0xde, 0x3c, 0xd9, 0x91, 0xbf, 0x21, 0x48, 0x45, 0xbd, 0x4d, 0xf0, 0xf6, 0x99, 0xe0, 0x43,
+	0x1f, 0xfc, 0xb7, 0xa3, 0xbf, 0x27, 0xe8, 0x77, 0xf1, 0xce, 0x19, 0xe8, 0xd5, 0xf7, 0x46, 0xef,
+	0xab, 0xd1, 0x00, 0x7f, 0x89, 0x60, 0xc6, 0xe7, 0x5d, 0x9e, 0x68, 0x39, 0xcc, 0xb7, 0x72, 0x8a,
+	0x94, 0x24, 0xdb, 0x10, 0x64, 0x2b, 0x78, 0x29, 0x4c, 0xa6, 0x8c, 0x33, 0xbd, 0x2f, 0x3b, 0xd9,
+	0x00, 0xff, 0x82, 0xe0, 0xda, 0x84, 0xb6, 0x83, 0xf5, 0x13, 0x6d, 0x8d, 0x37, 0xa8, 0xf4, 0xea,
+	0xc9, 0x0a, 0x3e, 0xdd, 0xae, 0xa0, 0x2b, 0xe0, 0x7c, 0x34, 0x5d, 0xd5, 0xa9, 0xa8, 0xde, 0xa6,
+	0xf7, 0xd5, 0x68, 0x80, 0x7f, 0x44, 0xf0, 0x7e, 0xa8, 0x33, 0xe1, 0x8d, 0xd3, 0x30, 0x03, 0xfd,
+	0xeb, 0xcc, 0x88, 0x91, 0x55, 0x3d, 0x82, 0xe8, 0x36, 0x38, 0xbd, 0xef, 0xfe, 0x1d, 0xe0, 0x2e,
+	0x5c, 0x92, 0x0f, 0x1a, 0x1c, 0x59, 0xa8, 0x81, 0xc7, 0x60, 0x7a, 0xf9, 0x64, 0x21, 0xc9, 0xb1,
+	0x28, 0x38, 0x6e, 0x66, 0x67, 0xc3, 0x1c, 0xee, 0xfb, 0xe7, 0x2e, 0x5a, 0x2f, 0x7e, 0xf6, 0xfa,
+	0x28, 0x83, 0xde, 0x1c, 0x65, 0xd0, 0x5f, 0x47, 0x19, 0xf4, 0xdd, 0x71, 0x66, 0xea, 0xcd, 0x71,
+	0x66, 0xea, 0x8f, 0xe3, 0xcc, 0xd4, 0xe7, 0xfb, 0xa6, 0xc5, 0xeb, 0xdd, 0xaa, 0x56, 0xa3, 0x4d,
+	0xdd, 0x6a, 0x59, 0xdc, 0x32, 0x36, 0x1b, 0x46, 0x95, 0xe9, 0xcf, 0x7a, 0xea, 0x28, 0xd6, 0xad,
+	0x36, 0xe9, 0x61, 0xb7, 0x41, 0x98, 0x7b, 0xea, 0x26, 0xb7, 0xbd, 0xdf, 0xd3, 0xee, 0xa4, 0x7a,
+	0x51, 0xfc, 0x72, 0xbe, 0xf3, 0xdf, 0x00, 0x2e, 0x89, 0x99, 0x7f, 0x23, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TxByEvmHash queries a transaction by its EVM tx hash
+	TxByEvmHash(ctx context.Context, in *QueryTxByEvmHashRequest, opts ...grpc.CallOption) (*QueryTxByEvmHashResponse, error)
+	// EvmTxHashes queries the EVM tx hashes of the transactions
+	EvmTxHashes(ctx context.Context, in *QueryEvmTxHashesRequest, opts ...grpc.CallOption) (*QueryEvmTxHashesResponse, error)
+	// Txs queries all transactions with their EVM tx hashes
+	Txs(ctx context.Context, in *types1.QueryTxsRequest, opts ...grpc.CallOption) (*QueryEvmTxsResponse, error)
+	// TxsByAccount queries all transactions of given account with their EVM tx
+	// hashes
+	TxsByAccount(ctx context.Context, in *types1.QueryTxsByAccountRequest, opts ...grpc.CallOption) (*QueryEvmTxsResponse, error)
+	// TxsByHeight queries all transactions of given height with their EVM tx
+	// hashes
+	TxsByHeight(ctx context.Context, in *types1.QueryTxsByHeightRequest, opts ...grpc.CallOption) (*QueryEvmTxsResponse, error)
+	// ERC20TransfersByAccount queries the ERC-20 transfers sent or received by
+	// given account
+	ERC20TransfersByAccount(ctx context.Context, in *QueryERC20TransfersByAccountRequest, opts ...grpc.CallOption) (*QueryERC20TransfersResponse, error)
+	// ERC20TransfersByContract queries the ERC-20 transfers of given token
+	// contract
+	ERC20TransfersByContract(ctx context.Context, in *QueryERC20TransfersByContractRequest, opts ...grpc.CallOption) (*QueryERC20TransfersResponse, error)
+	// Contract queries the deployment of given contract
+	Contract(ctx context.Context, in *QueryContractRequest, opts ...grpc.CallOption) (*QueryContractResponse, error)
+	// ContractsByDeployer queries the contracts deployed by given account
+	ContractsByDeployer(ctx context.Context, in *QueryContractsByDeployerRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error)
+	// ContractsByType queries the ERC-20 or the ERC-721 contracts
+	ContractsByType(ctx context.Context, in *QueryContractsByTypeRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error)
+	// EvmLogs queries the EVM logs matching the filter as eth_getLogs does
+	EvmLogs(ctx context.Context, in *QueryEvmLogsRequest, opts ...grpc.CallOption) (*QueryEvmLogsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TxByEvmHash(ctx context.Context, in *QueryTxByEvmHashRequest, opts ...grpc.CallOption) (*QueryTxByEvmHashResponse, error) {
+	out := new(QueryTxByEvmHashResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/TxByEvmHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EvmTxHashes(ctx context.Context, in *QueryEvmTxHashesRequest, opts ...grpc.CallOption) (*QueryEvmTxHashesResponse, error) {
+	out := new(QueryEvmTxHashesResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/EvmTxHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Txs(ctx context.Context, in *types1.QueryTxsRequest, opts ...grpc.CallOption) (*QueryEvmTxsResponse, error) {
+	out := new(QueryEvmTxsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/Txs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxsByAccount(ctx context.Context, in *types1.QueryTxsByAccountRequest, opts ...grpc.CallOption) (*QueryEvmTxsResponse, error) {
+	out := new(QueryEvmTxsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/TxsByAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxsByHeight(ctx context.Context, in *types1.QueryTxsByHeightRequest, opts ...grpc.CallOption) (*QueryEvmTxsResponse, error) {
+	out := new(QueryEvmTxsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/TxsByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20TransfersByAccount(ctx context.Context, in *QueryERC20TransfersByAccountRequest, opts ...grpc.CallOption) (*QueryERC20TransfersResponse, error) {
+	out := new(QueryERC20TransfersResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/ERC20TransfersByAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20TransfersByContract(ctx context.Context, in *QueryERC20TransfersByContractRequest, opts ...grpc.CallOption) (*QueryERC20TransfersResponse, error) {
+	out := new(QueryERC20TransfersResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/ERC20TransfersByContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Contract(ctx context.Context, in *QueryContractRequest, opts ...grpc.CallOption) (*QueryContractResponse, error) {
+	out := new(QueryContractResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/Contract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractsByDeployer(ctx context.Context, in *QueryContractsByDeployerRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error) {
+	out := new(QueryContractsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/ContractsByDeployer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractsByType(ctx context.Context, in *QueryContractsByTypeRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error) {
+	out := new(QueryContractsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/ContractsByType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EvmLogs(ctx context.Context, in *QueryEvmLogsRequest, opts ...grpc.CallOption) (*QueryEvmLogsResponse, error) {
+	out := new(QueryEvmLogsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.evm.v1.Query/EvmLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TxByEvmHash queries a transaction by its EVM tx hash
+	TxByEvmHash(context.Context, *QueryTxByEvmHashRequest) (*QueryTxByEvmHashResponse, error)
+	// EvmTxHashes queries the EVM tx hashes of the transactions
+	EvmTxHashes(context.Context, *QueryEvmTxHashesRequest) (*QueryEvmTxHashesResponse, error)
+	// Txs queries all transactions with their EVM tx hashes
+	Txs(context.Context, *types1.QueryTxsRequest) (*QueryEvmTxsResponse, error)
+	// TxsByAccount queries all transactions of given account with their EVM tx
+	// hashes
+	TxsByAccount(context.Context, *types1.QueryTxsByAccountRequest) (*QueryEvmTxsResponse, error)
+	// TxsByHeight queries all transactions of given height with their EVM tx
+	// hashes
+	TxsByHeight(context.Context, *types1.QueryTxsByHeightRequest) (*QueryEvmTxsResponse, error)
+	// ERC20TransfersByAccount queries the ERC-20 transfers sent or received by
+	// given account
+	ERC20TransfersByAccount(context.Context, *QueryERC20TransfersByAccountRequest) (*QueryERC20TransfersResponse, error)
+	// ERC20TransfersByContract queries the ERC-20 transfers of given token
+	// contract
+	ERC20TransfersByContract(context.Context, *QueryERC20TransfersByContractRequest) (*QueryERC20TransfersResponse, error)
+	// Contract queries the deployment of given contract
+	Contract(context.Context, *QueryContractRequest) (*QueryContractResponse, error)
+	// ContractsByDeployer queries the contracts deployed by given account
+	ContractsByDeployer(context.Context, *QueryContractsByDeployerRequest) (*QueryContractsResponse, error)
+	// ContractsByType queries the ERC-20 or the ERC-721 contracts
+	ContractsByType(context.Context, *QueryContractsByTypeRequest) (*QueryContractsResponse, error)
+	// EvmLogs queries the EVM logs matching the filter as eth_getLogs does
+	EvmLogs(context.Context, *QueryEvmLogsRequest) (*QueryEvmLogsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TxByEvmHash(ctx context.Context, req *QueryTxByEvmHashRequest) (*QueryTxByEvmHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxByEvmHash not implemented")
+}
+func (*UnimplementedQueryServer) EvmTxHashes(ctx context.Context, req *QueryEvmTxHashesRequest) (*QueryEvmTxHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvmTxHashes not implemented")
+}
+func (*UnimplementedQueryServer) Txs(ctx context.Context, req *types1.QueryTxsRequest) (*QueryEvmTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Txs not implemented")
+}
+func (*UnimplementedQueryServer) TxsByAccount(ctx context.Context, req *types1.QueryTxsByAccountRequest) (*QueryEvmTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxsByAccount not implemented")
+}
+func (*UnimplementedQueryServer) TxsByHeight(ctx context.Context, req *types1.QueryTxsByHeightRequest) (*QueryEvmTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxsByHeight not implemented")
+}
+func (*UnimplementedQueryServer) ERC20TransfersByAccount(ctx context.Context, req *QueryERC20TransfersByAccountRequest) (*QueryERC20TransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20TransfersByAccount not implemented")
+}
+func (*UnimplementedQueryServer) ERC20TransfersByContract(ctx context.Context, req *QueryERC20TransfersByContractRequest) (*QueryERC20TransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20TransfersByContract not implemented")
+}
+func (*UnimplementedQueryServer) Contract(ctx context.Context, req *QueryContractRequest) (*QueryContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contract not implemented")
+}
+func (*UnimplementedQueryServer) ContractsByDeployer(ctx context.Context, req *QueryContractsByDeployerRequest) (*QueryContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByDeployer not implemented")
+}
+func (*UnimplementedQueryServer) ContractsByType(ctx context.Context, req *QueryContractsByTypeRequest) (*QueryContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByType not implemented")
+}
+func (*UnimplementedQueryServer) EvmLogs(ctx context.Context, req *QueryEvmLogsRequest) (*QueryEvmLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvmLogs not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TxByEvmHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxByEvmHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxByEvmHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/TxByEvmHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxByEvmHash(ctx, req.(*QueryTxByEvmHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvmTxHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvmTxHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvmTxHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/EvmTxHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvmTxHashes(ctx, req.(*QueryEvmTxHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Txs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types1.QueryTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Txs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/Txs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Txs(ctx, req.(*types1.QueryTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types1.QueryTxsByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/TxsByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxsByAccount(ctx, req.(*types1.QueryTxsByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxsByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types1.QueryTxsByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxsByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/TxsByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxsByHeight(ctx, req.(*types1.QueryTxsByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20TransfersByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20TransfersByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20TransfersByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/ERC20TransfersByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20TransfersByAccount(ctx, req.(*QueryERC20TransfersByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20TransfersByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20TransfersByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20TransfersByContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/ERC20TransfersByContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20TransfersByContract(ctx, req.(*QueryERC20TransfersByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Contract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Contract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/Contract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Contract(ctx, req.(*QueryContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByDeployer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByDeployerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByDeployer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/ContractsByDeployer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByDeployer(ctx, req.(*QueryContractsByDeployerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/ContractsByType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByType(ctx, req.(*QueryContractsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvmLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvmLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvmLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.evm.v1.Query/EvmLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvmLogs(ctx, req.(*QueryEvmLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "indexer.evm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TxByEvmHash",
+			Handler:    _Query_TxByEvmHash_Handler,
+		},
+		{
+			MethodName: "EvmTxHashes",
+			Handler:    _Query_EvmTxHashes_Handler,
+		},
+		{
+			MethodName: "Txs",
+			Handler:    _Query_Txs_Handler,
+		},
+		{
+			MethodName: "TxsByAccount",
+			Handler:    _Query_TxsByAccount_Handler,
+		},
+		{
+			MethodName: "TxsByHeight",
+			Handler:    _Query_TxsByHeight_Handler,
+		},
+		{
+			MethodName: "ERC20TransfersByAccount",
+			Handler:    _Query_ERC20TransfersByAccount_Handler,
+		},
+		{
+			MethodName: "ERC20TransfersByContract",
+			Handler:    _Query_ERC20TransfersByContract_Handler,
+		},
+		{
+			MethodName: "Contract",
+			Handler:    _Query_Contract_Handler,
+		},
+		{
+			MethodName: "ContractsByDeployer",
+			Handler:    _Query_ContractsByDeployer_Handler,
+		},
+		{
+			MethodName: "ContractsByType",
+			Handler:    _Query_ContractsByType_Handler,
+		},
+		{
+			MethodName: "EvmLogs",
+			Handler:    _Query_EvmLogs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "indexer/evm/v1/query.proto",
+}
+
+func (m *QueryTxByEvmHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxByEvmHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxByEvmHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmTxHash) > 0 {
+		i -= len(m.EvmTxHash)
+		copy(dAtA[i:], m.EvmTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxByEvmHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxByEvmHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxByEvmHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmTxHash) > 0 {
+		i -= len(m.EvmTxHash)
+		copy(dAtA[i:], m.EvmTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmTxHashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmTxHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmTxHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmTxHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmTxHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmTxHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmTxHashes) > 0 {
+		for k := range m.EvmTxHashes {
+			v := m.EvmTxHashes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmTxHash) > 0 {
+		i -= len(m.EvmTxHash)
+		copy(dAtA[i:], m.EvmTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20TransfersByAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20TransfersByAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20TransfersByAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20TransfersByContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20TransfersByContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20TransfersByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20TransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20TransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20TransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByDeployerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByDeployerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByDeployerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deployer) > 0 {
+		i -= len(m.Deployer)
+		copy(dAtA[i:], m.Deployer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Deployer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTxByEvmHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxByEvmHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvmTxHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for _, s := range m.TxHashes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEvmTxHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EvmTxHashes) > 0 {
+		for k, v := range m.EvmTxHashes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryEvmTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EvmTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20TransfersByAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20TransfersByContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20TransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvmLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEvmLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByDeployerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Deployer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTxByEvmHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxByEvmHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxByEvmHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxByEvmHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxByEvmHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxByEvmHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &types.TxResponse{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvmTxHashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvmTxHashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvmTxHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvmTxHashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvmTxHashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvmTxHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmTxHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvmTxHashes == nil {
+				m.EvmTxHashes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EvmTxHashes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvmTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvmTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvmTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, EvmTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &types.TxResponse{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20TransfersByAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20TransfersByAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20TransfersByAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20TransfersByContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20TransfersByContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20TransfersByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20TransfersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20TransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20TransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, ERC20Transfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvmLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvmLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvmLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, TopicFilter{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvmLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvmLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvmLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, EvmLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contract == nil {
+				m.Contract = &Contract{}
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByDeployerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByDeployerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByDeployerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deployer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, Contract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,17 +5,20 @@ const (
 	SubmoduleName = "evm-tx"
 
 	// Version is the current version of the submodule
-	Version = "v0.2.2"
+	Version = "v0.3.4"
 )
 
-// store prefixes
+// store prefixes of the EVM specific indices, following the prefixes of the tx indexer
 const (
-	TxsByAccountPrefix            = 0x10
-	AccountSequencePrefix         = 0x20
-	SequencePrefix                = 0xa0
-	TxSequencePrefix              = 0xb0
-	TxByHeightPrefix              = 0xc0
-	TxsPrefix                     = 0xf0
-	SequenceByHeightPrefix        = 0xd0
-	AccountSequenceByHeightPrefix = 0xe0
+	TxByEvmTxHashPrefix            = 0x95
+	EvmTxHashByTxPrefix            = 0x96
+	ERC20TransfersByAccountPrefix  = 0x97
+	ERC20TransfersByContractPrefix = 0x98
+	EvmLogsPrefix                  = 0x99
+	EvmLogsByContractPrefix        = 0x9a
+	EvmLogsByContractTopicPrefix   = 0x9b
+	EvmLogsByTopicPrefix           = 0x9c
+	ContractsPrefix                = 0x9d
+	ContractsByDeployerPrefix      = 0x9e
+	ContractsByTypePrefix          = 0x9f
 )
@@ -1,60 +1,28 @@
 package tx
 
 import (
-	"encoding/hex"
-	"fmt"
-	"regexp"
 	"strings"
 
+	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
-const (
-	initBech32RegexStr = "^init1(?:[a-z0-9]{38}|[a-z0-9]{58})$"
-	initHexRegexStr    = "0x(?:[a-f1-9][a-f0-9]*){1,64}"
-)
-
-var (
-	initBech32Regex = regexp.MustCompile(initBech32RegexStr)
-	initHexRegex    = regexp.MustCompile(initHexRegexStr)
-)
-
-func findAllBech32Address(str string) []string {
-	return initBech32Regex.FindAllString(str, -1)
-}
-
-func findAllHexAddress(str string) []string {
-	return initHexRegex.FindAllString(str, -1)
-}
-
-// use it because i want to make this submodule not depend on move vm/module
-func accAddressFromString(addrStr string) (addr sdk.AccAddress, err error) {
-	if strings.HasPrefix(addrStr, "0x") {
-		addrStr = strings.TrimPrefix(addrStr, "0x")
-
-		// add padding
-		if len(addrStr) <= 40 {
-			addrStr = strings.Repeat("0", 40-len(addrStr)) + addrStr
-		} else if len(addrStr) <= 64 {
-			addrStr = strings.Repeat("0", 64-len(addrStr)) + addrStr
-		} else {
-			return nil, fmt.Errorf("invalid address string: %s", addrStr)
-		}
-
-		if addr, err = hex.DecodeString(addrStr); err != nil {
-			return
-		}
-	} else if addr, err = sdk.AccAddressFromBech32(addrStr); err != nil {
-		return
+func convertContractAddressToBech32(ac address.Codec, addr string) (string, error) {
+	accAddr, err := evmAddressFromHex(addr)
+	if err != nil {
+		return "", err
 	}
-
-	return
+	return ac.BytesToString(accAddr)
 }
 
-func convertContractAddressToBech32(addr string) (string, error) {
-	accAddr, err := sdk.AccAddressFromHexUnsafe(strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(addr, "0x"), "000000000000000000000000")))
-	if err != nil {
-		return "", err
+// evmAddressFromHex returns the account address of the hex address, which may be left padded to 32 bytes
+// as in the topics. The padding is trimmed only from the padded ones, so that the 20 bytes address
+// beginning with zeros is kept as is.
+func evmAddressFromHex(addr string) (sdk.AccAddress, error) {
+	addr = strings.ToLower(strings.TrimPrefix(addr, "0x"))
+	if len(addr) == 2*common.HashLength {
+		addr = strings.TrimPrefix(addr, strings.Repeat("0", 2*(common.HashLength-common.AddressLength)))
 	}
-	return accAddr.String(), nil
+	return sdk.AccAddressFromHexUnsafe(addr)
 }
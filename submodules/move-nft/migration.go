@@ -19,6 +19,8 @@ var migrated sync.Once
 
 func (sm MoveNftSubmodule) migrateHandler(ctx context.Context) (err error) {
 	migrated.Do(func() {
+		sm.migrating.Store(true)
+		defer sm.migrating.Store(false)
 
 		value, e := sm.migrationInfo.Get(ctx, keyMigrateCollectionName)
 		if e != nil {
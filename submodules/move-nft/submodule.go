@@ -2,6 +2,7 @@ package move_nft
 
 import (
 	"context"
+	"sync/atomic"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -23,6 +24,9 @@ import (
 )
 
 var _ kvindexer.Submodule = MoveNftSubmodule{}
+var _ kvindexer.Verifier = MoveNftSubmodule{}
+var _ kvindexer.Reconciler = MoveNftSubmodule{}
+var _ kvindexer.MigrationStatus = MoveNftSubmodule{}
 
 type MoveNftSubmodule struct {
 	ac  address.Codec
@@ -43,6 +47,8 @@ type MoveNftSubmodule struct {
 	tokenOwnerMap *collections.Map[collections.Triple[sdk.AccAddress, sdk.AccAddress, string], bool]
 	// migrationInfo stores json and internal use only
 	migrationInfo *collections.Map[string, string]
+	// migrating is true while the migration is running
+	migrating *atomic.Bool
 }
 
 func NewMoveNftSubmodule(
@@ -101,6 +107,7 @@ func NewMoveNftSubmodule(
 		tokenMap:           tokenMap,
 		tokenOwnerMap:      tokenOwnerMap,
 		migrationInfo:      migrationMap,
+		migrating:          &atomic.Bool{},
 	}, nil
 }
 
@@ -149,3 +156,25 @@ func (sub MoveNftSubmodule) Commit(ctx context.Context, res abci.ResponseCommit,
 func (sub MoveNftSubmodule) Prune(ctx context.Context, minHeight int64) error {
 	return nil
 }
+
+func (sub MoveNftSubmodule) Verify(ctx context.Context) ([]kvindexer.InvariantViolation, error) {
+	return sub.indices().Verify(ctx, sub.ac)
+}
+
+// indices returns the indices shared by the nft submodules.
+func (sub MoveNftSubmodule) indices() nfttypes.Indices {
+	return nfttypes.Indices{
+		Collections:      sub.collectionMap,
+		CollectionOwners: sub.collectionOwnerMap,
+		Tokens:           sub.tokenMap,
+		TokenOwners:      sub.tokenOwnerMap,
+	}
+}
+
+func (sub MoveNftSubmodule) Reconcile(ctx context.Context) ([]kvindexer.Correction, []kvindexer.ReconcileFailure, error) {
+	return sub.indices().Reconcile(ctx, sub.ac, sub.getAuthoritativeToken, sub.getAuthoritativeCollection)
+}
+
+func (sub MoveNftSubmodule) IsMigrating() bool {
+	return sub.migrating.Load()
+}
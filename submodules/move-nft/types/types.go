@@ -26,3 +26,13 @@ type NftResource struct {
 	//CollectionAddr string `json:"collection_addr,omitempty"`
 	//ObjectAddr     string `json:"object_addr,omitempty"`
 }
+
+// internal use only: struct from move resource
+type ObjectCoreResource struct {
+	Type       string     `json:"type"`
+	ObjectCore ObjectCore `json:"data"`
+}
+
+type ObjectCore struct {
+	Owner string `json:"owner"`
+}
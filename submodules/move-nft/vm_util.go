@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -29,6 +30,11 @@ var nftStructTag = vmtypes.StructTag{
 	Module:  "nft",
 	Name:    "Nft",
 }
+var objectCoreStructTag = vmtypes.StructTag{
+	Address: vmtypes.StdAddress,
+	Module:  "object",
+	Name:    "ObjectCore",
+}
 
 func (sm MoveNftSubmodule) getCollectionFromVMStore(ctx context.Context, colAddr vmtypes.AccountAddress) (*types.CollectionResource, error) {
 	rb, err := sm.vmKeeper.GetResource(ctx, colAddr, collectionStructTag)
@@ -82,6 +88,57 @@ func (sm MoveNftSubmodule) getIndexedTokenFromVMStore(ctx context.Context, nftAd
 	return &indexed, nil
 }
 
+func (sm MoveNftSubmodule) getObjectOwnerFromVMStore(ctx context.Context, objectAddr vmtypes.AccountAddress) (vmtypes.AccountAddress, error) {
+	rb, err := sm.vmKeeper.GetResource(ctx, objectAddr, objectCoreStructTag)
+	if err != nil {
+		return vmtypes.AccountAddress{}, status.Error(codes.NotFound, err.Error())
+	}
+	resource := types.ObjectCoreResource{}
+	if err := json.Unmarshal([]byte(rb.MoveResource), &resource); err != nil {
+		return vmtypes.AccountAddress{}, status.Error(codes.Internal, err.Error())
+	}
+	return getVMAddress(sm.ac, resource.ObjectCore.Owner)
+}
+
+// getAuthoritativeToken returns the token and its owner from the VM store
+func (sm MoveNftSubmodule) getAuthoritativeToken(ctx context.Context, _ collections.Pair[sdk.AccAddress, string], token nfttypes.IndexedToken) (*nfttypes.Token, sdk.AccAddress, error) {
+	nftAddr, err := getVMAddress(sm.ac, token.ObjectAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resource, err := sm.getNftResourceFromVMStore(ctx, nftAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownerAddr, err := sm.getObjectOwnerFromVMStore(ctx, nftAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &resource.Nft, getCosmosAddress(ownerAddr), nil
+}
+
+// getAuthoritativeCollection returns the collection from the VM store
+func (sm MoveNftSubmodule) getAuthoritativeCollection(ctx context.Context, collectionAddr sdk.AccAddress) (*nfttypes.Collection, error) {
+	addr, err := sm.ac.BytesToString(collectionAddr)
+	if err != nil {
+		return nil, err
+	}
+	colAddr, err := getVMAddress(sm.ac, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := sm.getCollectionFromVMStore(ctx, colAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resource.Collection, nil
+}
+
 func getVMAddress(ac address.Codec, addr string) (vmtypes.AccountAddress, error) {
 	accAddr, err := movetypes.AccAddressFromString(ac, addr)
 	if err != nil {
@@ -0,0 +1,124 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/submodules/move-tx/types/move"
+	txindexer "github.com/initia-labs/kvindexer/tx"
+)
+
+const attributeKeyTypeTag = "type_tag"
+
+// moveEventKey is the key of the move event in the indices by the module
+type moveEventKey struct {
+	addr sdk.AccAddress
+	// module is the name of the module, and typ is the rest of the type tag following the address
+	module string
+	typ    string
+}
+
+// grepMoveEvents returns the move events of the tx in the emitted order.
+// the index is kept for the events following an unparsable one.
+func grepMoveEvents(txr *sdk.TxResponse, blockIndex uint32) []move.MoveEvent {
+	events := []move.MoveEvent{}
+	var index uint32
+	for _, event := range txr.Events {
+		if event.Type != eventTypeMove {
+			continue
+		}
+
+		moveEvent := move.MoveEvent{
+			Height:     txr.Height,
+			TxHash:     txr.TxHash,
+			TxIndex:    blockIndex,
+			EventIndex: index,
+		}
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case attributeKeyTypeTag:
+				moveEvent.TypeTag = attr.Value
+			case attributeKeyData:
+				moveEvent.Data = attr.Value
+			}
+		}
+		if moveEvent.TypeTag != "" {
+			events = append(events, moveEvent)
+		}
+		index++
+	}
+	return events
+}
+
+// parseTypeTag splits the type tag into the module address, the module name and the rest following the address,
+// e.g. 0x1, fungible_asset and fungible_asset::DepositEvent of 0x1::fungible_asset::DepositEvent.
+// The module name is empty if the type tag is the module address only.
+func parseTypeTag(ac address.Codec, typeTag string) (moveEventKey, error) {
+	addrStr, typ, found := strings.Cut(typeTag, "::")
+	if addrStr == "" || (found && typ == "") {
+		return moveEventKey{}, fmt.Errorf("invalid type tag: %s", typeTag)
+	}
+
+	addr, err := txindexer.AccAddressFromString(ac, addrStr)
+	if err != nil {
+		return moveEventKey{}, err
+	}
+
+	module, _, _ := strings.Cut(typ, "::")
+	return moveEventKey{addr, module, typ}, nil
+}
+
+// storeMoveEvents stores the move events of the tx keyed by its sequence and the event index
+func (sm MoveTxSubmodule) storeMoveEvents(ctx context.Context, seq uint64, idx txindexer.IndexedTx) error {
+	for _, event := range grepMoveEvents(idx.TxResponse, idx.BlockIndex) {
+		key, err := parseTypeTag(sm.ac, event.TypeTag)
+		if err != nil || key.module == "" {
+			continue
+		}
+
+		pos := collections.Join(seq, event.EventIndex)
+		if err = sm.moveEventMap.Set(ctx, pos, event); err != nil {
+			return err
+		}
+		if err = sm.moveEventsByAddressMap.Set(ctx, collections.Join3(key.addr, seq, event.EventIndex), true); err != nil {
+			return err
+		}
+		if err = sm.moveEventsByModuleMap.Set(ctx, collections.Join3(key.addr, key.module, pos), true); err != nil {
+			return err
+		}
+		if err = sm.moveEventsByTypeTagMap.Set(ctx, collections.Join3(key.addr, key.typ, pos), true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeMoveEvents removes the move events of the tx keyed by its sequence and the event index
+func (sm MoveTxSubmodule) removeMoveEvents(ctx context.Context, seq uint64, txr *sdk.TxResponse) error {
+	for _, event := range grepMoveEvents(txr, 0) {
+		key, err := parseTypeTag(sm.ac, event.TypeTag)
+		if err != nil || key.module == "" {
+			continue
+		}
+
+		pos := collections.Join(seq, event.EventIndex)
+		if err = sm.moveEventMap.Remove(ctx, pos); err != nil {
+			return err
+		}
+		if err = sm.moveEventsByAddressMap.Remove(ctx, collections.Join3(key.addr, seq, event.EventIndex)); err != nil {
+			return err
+		}
+		if err = sm.moveEventsByModuleMap.Remove(ctx, collections.Join3(key.addr, key.module, pos)); err != nil {
+			return err
+		}
+		if err = sm.moveEventsByTypeTagMap.Remove(ctx, collections.Join3(key.addr, key.typ, pos)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package tx
+
+import (
+	"bytes"
+	"testing"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseTypeTag(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("init")
+	stdAddr := sdk.AccAddress(append(make([]byte, 19), 1))
+	bech32Addr, err := ac.BytesToString(stdAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		typeTag string
+		want    moveEventKey
+		wantErr bool
+	}{
+		{"event type", "0x1::fungible_asset::DepositEvent", moveEventKey{stdAddr, "fungible_asset", "fungible_asset::DepositEvent"}, false},
+		{"generic event type", "0x1::coin::CoinEvent<0x1::native_uinit::Coin>", moveEventKey{stdAddr, "coin", "coin::CoinEvent<0x1::native_uinit::Coin>"}, false},
+		{"module", "0x1::fungible_asset", moveEventKey{stdAddr, "fungible_asset", "fungible_asset"}, false},
+		{"module address", "0x1", moveEventKey{stdAddr, "", ""}, false},
+		{"bech32 module address", bech32Addr + "::dex::SwapEvent", moveEventKey{stdAddr, "dex", "dex::SwapEvent"}, false},
+		{"empty", "", moveEventKey{}, true},
+		{"no address", "::fungible_asset::DepositEvent", moveEventKey{}, true},
+		{"no type", "0x1::", moveEventKey{}, true},
+		{"invalid address", "0xzz::fungible_asset::DepositEvent", moveEventKey{}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseTypeTag(ac, tc.typeTag)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tc.wantErr)
+			}
+			if !bytes.Equal(got.addr, tc.want.addr) || got.module != tc.want.module || got.typ != tc.want.typ {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package tx
+
+import (
+	"encoding/json"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/core/address"
+
+	txindexer "github.com/initia-labs/kvindexer/tx"
+	txtypes "github.com/initia-labs/kvindexer/tx/types"
+)
+
+const (
+	eventTypeMove    = "move"
+	attributeKeyData = "data"
+)
+
+var (
+	// moveAddressRegex matches the JSON string of the address-typed fields, which may be
+	// shortened by omitting the leading zeros (e.g. 0x1). vector<u8> fields are encoded alike,
+	// so the fields are matched by their names too.
+	moveAddressRegex = regexp.MustCompile(`^0x[0-9a-fA-F]{1,64}$`)
+
+	// addressFieldNames are the names of the address-typed fields of the move events, such as the object
+	// transfers and the fungible asset deposits. The names ending with _addr or _address are matched as well.
+	addressFieldNames = map[string]bool{
+		"account": true, "owner": true, "creator": true, "delegator": true,
+		"object": true, "collection": true, "nft": true, "token": true, "store": true, "metadata": true,
+		"sender": true, "spender": true, "from": true, "recipient": true, "receiver": true, "to": true,
+	}
+
+	senderFields    = map[string]bool{"sender": true, "spender": true, "from": true}
+	recipientFields = map[string]bool{"recipient": true, "receiver": true, "to": true}
+)
+
+var _ txindexer.AccountExtractor = moveAccountExtractor{}
+
+// moveAccountExtractor finds the accounts in the JSON data of the move events, e.g. the store and
+// the metadata addresses of the fungible asset deposits and withdrawals
+type moveAccountExtractor struct {
+	ac address.Codec
+}
+
+// ExtractAddresses implements txindexer.AccountExtractor.
+func (moveAccountExtractor) ExtractAddresses(eventType, key, value string) ([]string, bool) {
+	if eventType != eventTypeMove || key != attributeKeyData {
+		return nil, false
+	}
+
+	addrs := []string{}
+	for _, field := range addressFields(value) {
+		addrs = append(addrs, field.addr)
+	}
+	return addrs, true
+}
+
+// ExtractRoles implements txindexer.AccountExtractor. The roles are found by the field names of the
+// data as the attribute keys of the other events, e.g. from and to of the object transfers.
+func (e moveAccountExtractor) ExtractRoles(eventType, key, value string) ([]txindexer.AccountRole, bool) {
+	if eventType != eventTypeMove || key != attributeKeyData {
+		return nil, false
+	}
+
+	roles := []txindexer.AccountRole{}
+	for _, field := range addressFields(value) {
+		var role txtypes.TxRole
+
+		switch {
+		case senderFields[field.name]:
+			role = txtypes.TxRoleSender
+		case recipientFields[field.name]:
+			role = txtypes.TxRoleRecipient
+		default:
+			continue
+		}
+
+		acc, err := txindexer.AccAddressFromString(e.ac, field.addr)
+		if err != nil {
+			continue
+		}
+		roles = append(roles, txindexer.AccountRole{Account: acc, Role: role})
+	}
+	return roles, true
+}
+
+// addressField is an address-typed field of the move event data
+type addressField struct {
+	// name is the name of the field, or of the vector field containing the address
+	name string
+	addr string
+}
+
+// isAddressFieldName returns whether the field of the name is address-typed
+func isAddressFieldName(name string) bool {
+	return addressFieldNames[name] || strings.HasSuffix(name, "_addr") || strings.HasSuffix(name, "_address")
+}
+
+// addressFields returns the address-typed fields of the JSON data at any depth, in the order of the field names
+func addressFields(data string) []addressField {
+	var payload any
+	if err := json.Unmarshal([]byte(data), &payload); err != nil {
+		return nil
+	}
+
+	fields := []addressField{}
+	var walk func(v any, name string)
+	walk = func(v any, name string) {
+		switch v := v.(type) {
+		case string:
+			if isAddressFieldName(name) && moveAddressRegex.MatchString(v) {
+				fields = append(fields, addressField{name, v})
+			}
+		case []any:
+			for _, elem := range v {
+				walk(elem, name)
+			}
+		case map[string]any:
+			for _, key := range slices.Sorted(maps.Keys(v)) {
+				walk(v[key], key)
+			}
+		}
+	}
+	walk(payload, "")
+
+	return fields
+}
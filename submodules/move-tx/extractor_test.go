@@ -0,0 +1,58 @@
+package tx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAddressFields(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []addressField
+	}{
+		{
+			name: "deposit event",
+			data: `{"store_addr":"0x1234","metadata_addr":"0xabcd","amount":"100"}`,
+			want: []addressField{{"metadata_addr", "0xabcd"}, {"store_addr", "0x1234"}},
+		},
+		{
+			name: "known field names",
+			data: `{"sender":"0x1","recipient":"0x2","object":"0x3"}`,
+			want: []addressField{{"object", "0x3"}, {"recipient", "0x2"}, {"sender", "0x1"}},
+		},
+		{
+			name: "nested object and vector",
+			data: `{"transfer":{"from":"0x1","to":["0x2","0x3"]}}`,
+			want: []addressField{{"from", "0x1"}, {"to", "0x2"}, {"to", "0x3"}},
+		},
+		{
+			name: "hex value of a non-address field",
+			data: `{"hash":"0xdeadbeef","key":"0x1"}`,
+			want: []addressField{},
+		},
+		{
+			name: "non-hex value of an address field",
+			data: `{"owner":"init1abc","creator":"0x"}`,
+			want: []addressField{},
+		},
+		{
+			name: "too long hex",
+			data: `{"owner":"0x` + "11111111111111111111111111111111111111111111111111111111111111111" + `"}`,
+			want: []addressField{},
+		},
+		{
+			name: "invalid json",
+			data: `{"owner":`,
+			want: nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := addressFields(tc.data)
+			if !slices.Equal(got, tc.want) || (got == nil) != (tc.want == nil) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
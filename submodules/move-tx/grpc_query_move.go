@@ -0,0 +1,103 @@
+package tx
+
+import (
+	"context"
+	"math"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/initia-labs/kvindexer/submodules/move-tx/types/move"
+	"github.com/initia-labs/kvindexer/util"
+)
+
+var _ move.QueryServer = (*MoveQuerier)(nil)
+
+// MoveQuerier serves the queries of the Move specific data of the txs
+type MoveQuerier struct {
+	MoveTxSubmodule
+}
+
+func NewMoveQuerier(sb MoveTxSubmodule) move.QueryServer {
+	return MoveQuerier{sb}
+}
+
+// MoveEvents implements move.QueryServer.
+func (q MoveQuerier) MoveEvents(ctx context.Context, req *move.QueryMoveEventsRequest) (*move.QueryMoveEventsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative height")
+	}
+
+	var key moveEventKey
+	var err error
+	switch {
+	case req.TypeTag != "":
+		if key, err = parseTypeTag(q.ac, req.TypeTag); err != nil || key.module == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid type tag: %s", req.TypeTag)
+		}
+	case req.Module != "":
+		if key, err = parseTypeTag(q.ac, req.Module); err != nil || key.typ != key.module {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid module: %s", req.Module)
+		}
+	}
+
+	startSeq, endSeq := uint64(0), uint64(math.MaxUint64)
+	if req.FromHeight > 0 || req.ToHeight > 0 {
+		if startSeq, endSeq, err = q.SequenceRange(ctx, req.FromHeight, req.ToHeight); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// use the most specific index of the filter
+	var events []move.MoveEvent
+	var pageRes *query.PageResponse
+	switch {
+	case req.TypeTag != "":
+		start := collections.Join3(key.addr, key.typ, collections.Join(startSeq, uint32(0)))
+		end := collections.Join3(key.addr, key.typ, collections.Join(endSeq, uint32(0)))
+		events, pageRes, err = util.IndexRangePaginate(ctx, q.moveEventsByTypeTagMap, q.moveEventMap, &start, &end, req.Pagination,
+			func(key collections.Triple[sdk.AccAddress, string, collections.Pair[uint64, uint32]]) collections.Pair[uint64, uint32] {
+				return key.K3()
+			},
+			nil,
+		)
+	case key.module != "":
+		start := collections.Join3(key.addr, key.module, collections.Join(startSeq, uint32(0)))
+		end := collections.Join3(key.addr, key.module, collections.Join(endSeq, uint32(0)))
+		events, pageRes, err = util.IndexRangePaginate(ctx, q.moveEventsByModuleMap, q.moveEventMap, &start, &end, req.Pagination,
+			func(key collections.Triple[sdk.AccAddress, string, collections.Pair[uint64, uint32]]) collections.Pair[uint64, uint32] {
+				return key.K3()
+			},
+			nil,
+		)
+	case key.addr != nil:
+		start := collections.Join3(key.addr, startSeq, uint32(0))
+		end := collections.Join3(key.addr, endSeq, uint32(0))
+		events, pageRes, err = util.IndexRangePaginate(ctx, q.moveEventsByAddressMap, q.moveEventMap, &start, &end, req.Pagination,
+			func(key collections.Triple[sdk.AccAddress, uint64, uint32]) collections.Pair[uint64, uint32] {
+				return collections.Join(key.K2(), key.K3())
+			},
+			nil,
+		)
+	default:
+		start := collections.Join(startSeq, uint32(0))
+		end := collections.Join(endSeq, uint32(0))
+		events, pageRes, err = util.CollectionRangePaginate(ctx, q.moveEventMap, &start, &end, req.Pagination, nil,
+			func(_ collections.Pair[uint64, uint32], event move.MoveEvent) (move.MoveEvent, error) {
+				return event, nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &move.QueryMoveEventsResponse{
+		Events:     events,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package tx
+
+import (
+	"context"
+
+	txindexer "github.com/initia-labs/kvindexer/tx"
+)
+
+// StoreTx implements txindexer.IndexHook. It stores the Move specific indices of the tx.
+func (sm MoveTxSubmodule) StoreTx(ctx context.Context, seq uint64, idx txindexer.IndexedTx) error {
+	return sm.storeMoveEvents(ctx, seq, idx)
+}
+
+// RemoveTx implements txindexer.IndexHook.
+func (sm MoveTxSubmodule) RemoveTx(ctx context.Context, seq uint64, idx txindexer.IndexedTx) error {
+	return sm.removeMoveEvents(ctx, seq, idx.TxResponse)
+}
@@ -0,0 +1,98 @@
+package tx
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/grpc"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/initia-labs/kvindexer/collection"
+	"github.com/initia-labs/kvindexer/submodules/move-tx/types"
+	"github.com/initia-labs/kvindexer/submodules/move-tx/types/move"
+	txindexer "github.com/initia-labs/kvindexer/tx"
+	kvindexer "github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+var _ kvindexer.Submodule = MoveTxSubmodule{}
+var _ kvindexer.Verifier = MoveTxSubmodule{}
+var _ txindexer.IndexHook = MoveTxSubmodule{}
+
+// MoveTxSubmodule indexes the txs with the accounts found in the JSON data of the move events,
+// in addition to the cosmos events and messages, along with the move events by their type tags.
+type MoveTxSubmodule struct {
+	txindexer.Indexer
+
+	ac address.Codec
+
+	moveEventMap           *collections.Map[collections.Pair[uint64, uint32], move.MoveEvent]
+	moveEventsByAddressMap *collections.Map[collections.Triple[sdk.AccAddress, uint64, uint32], bool]
+	moveEventsByModuleMap  *collections.Map[collections.Triple[sdk.AccAddress, string, collections.Pair[uint64, uint32]], bool]
+	moveEventsByTypeTagMap *collections.Map[collections.Triple[sdk.AccAddress, string, collections.Pair[uint64, uint32]], bool]
+}
+
+func NewTxSubmodule(
+	cdc codec.Codec,
+	indexerKeeper collection.IndexerKeeper,
+	opts ...Option,
+) (*MoveTxSubmodule, error) {
+	prefixMoveEvents := collection.NewPrefix(types.SubmoduleName, types.MoveEventsPrefix)
+	moveEventMap, err := collection.AddMap(indexerKeeper, prefixMoveEvents, "move_events", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[move.MoveEvent](cdc))
+	if err != nil {
+		return nil, err
+	}
+
+	prefixMoveEventsByAddress := collection.NewPrefix(types.SubmoduleName, types.MoveEventsByAddressPrefix)
+	moveEventsByAddressMap, err := collection.AddMap(indexerKeeper, prefixMoveEventsByAddress, "move_events_by_address", collections.TripleKeyCodec(sdk.AccAddressKey, collections.Uint64Key, collections.Uint32Key), collections.BoolValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixMoveEventsByModule := collection.NewPrefix(types.SubmoduleName, types.MoveEventsByModulePrefix)
+	moveEventsByModuleMap, err := collection.AddMap(indexerKeeper, prefixMoveEventsByModule, "move_events_by_module", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key)), collections.BoolValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixMoveEventsByTypeTag := collection.NewPrefix(types.SubmoduleName, types.MoveEventsByTypeTagPrefix)
+	moveEventsByTypeTagMap, err := collection.AddMap(indexerKeeper, prefixMoveEventsByTypeTag, "move_events_by_type_tag", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key)), collections.BoolValue)
+	if err != nil {
+		return nil, err
+	}
+
+	ac := indexerKeeper.GetAddressCodec()
+	sub := &MoveTxSubmodule{
+		ac: ac,
+
+		moveEventMap:           moveEventMap,
+		moveEventsByAddressMap: moveEventsByAddressMap,
+		moveEventsByModuleMap:  moveEventsByModuleMap,
+		moveEventsByTypeTagMap: moveEventsByTypeTagMap,
+	}
+
+	// the hook only uses the Move specific indices, so it is set before the indexer is created
+	indexer, err := txindexer.NewIndexer(types.SubmoduleName, types.Version, cdc, indexerKeeper, moveAccountExtractor{ac}, append(opts, txindexer.WithIndexHook(sub))...)
+	if err != nil {
+		return nil, err
+	}
+	sub.Indexer = *indexer
+
+	return sub, nil
+}
+
+func (sub MoveTxSubmodule) RegisterQueryHandlerClient(cc client.Context, mux *runtime.ServeMux) error {
+	if err := sub.Indexer.RegisterQueryHandlerClient(cc, mux); err != nil {
+		return err
+	}
+	return move.RegisterQueryHandlerClient(context.Background(), mux, move.NewQueryClient(cc))
+}
+
+func (sub MoveTxSubmodule) RegisterQueryServer(s grpc.Server) {
+	sub.Indexer.RegisterQueryServer(s)
+	move.RegisterQueryServer(s, NewMoveQuerier(sub))
+}
@@ -0,0 +1,17 @@
+package types
+
+const (
+	// SubmoduleName is the name of the submodule
+	SubmoduleName = "move-tx"
+
+	// Version is the current version of the submodule
+	Version = "v0.1.1"
+)
+
+// store prefixes of the Move specific indices, following the prefixes of the tx indexer
+const (
+	MoveEventsPrefix          = 0x95
+	MoveEventsByAddressPrefix = 0x96
+	MoveEventsByModulePrefix  = 0x97
+	MoveEventsByTypeTagPrefix = 0x98
+)
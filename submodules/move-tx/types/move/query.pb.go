@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: indexer/move/v1/query.proto
+
+package move
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryMoveEventsRequest is the request type for the Query/MoveEvents RPC
+// method
+type QueryMoveEventsRequest struct {
+	// type_tag is the exact type of the events, e.g.
+	// 0x1::fungible_asset::DepositEvent. The address may be in the short or the
+	// long form.
+	TypeTag string `protobuf:"bytes,1,opt,name=type_tag,json=typeTag,proto3" json:"type_tag,omitempty"`
+	// module is the address of the module, e.g. 0x1, optionally followed by the
+	// module name, e.g. 0x1::fungible_asset. It is ignored if type_tag is set.
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// from_height and to_height are the inclusive height range of the events.
+	// Zero means unbounded.
+	FromHeight int64              `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64              `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMoveEventsRequest) Reset()         { *m = QueryMoveEventsRequest{} }
+func (m *QueryMoveEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMoveEventsRequest) ProtoMessage()    {}
+func (*QueryMoveEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4c65003b7e91e76, []int{0}
+}
+func (m *QueryMoveEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMoveEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMoveEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMoveEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMoveEventsRequest.Merge(m, src)
+}
+func (m *QueryMoveEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMoveEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMoveEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMoveEventsRequest proto.InternalMessageInfo
+
+func (m *QueryMoveEventsRequest) GetTypeTag() string {
+	if m != nil {
+		return m.TypeTag
+	}
+	return ""
+}
+
+func (m *QueryMoveEventsRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *QueryMoveEventsRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryMoveEventsRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryMoveEventsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMoveEventsResponse is the response type for the Query/MoveEvents RPC
+// method
+type QueryMoveEventsResponse struct {
+	Events     []MoveEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMoveEventsResponse) Reset()         { *m = QueryMoveEventsResponse{} }
+func (m *QueryMoveEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMoveEventsResponse) ProtoMessage()    {}
+func (*QueryMoveEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4c65003b7e91e76, []int{1}
+}
+func (m *QueryMoveEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMoveEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMoveEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMoveEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMoveEventsResponse.Merge(m, src)
+}
+func (m *QueryMoveEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMoveEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMoveEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMoveEventsResponse proto.InternalMessageInfo
+
+func (m *QueryMoveEventsResponse) GetEvents() []MoveEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *QueryMoveEventsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryMoveEventsRequest)(nil), "indexer.move.v1.QueryMoveEventsRequest")
+	proto.RegisterType((*QueryMoveEventsResponse)(nil), "indexer.move.v1.QueryMoveEventsResponse")
+}
+
+func init() { proto.RegisterFile("indexer/move/v1/query.proto", fileDescriptor_c4c65003b7e91e76) }
+
+var fileDescriptor_c4c65003b7e91e76 = []byte{
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3d, 0x6f, 0x13, 0x4d,
+	0x10, 0xc7, 0xbd, 0x76, 0xe2, 0x27, 0x59, 0x17, 0x8f, 0xb4, 0x42, 0xc9, 0xc5, 0x41, 0x67, 0xcb,
+	0x05, 0x39, 0x21, 0x65, 0x57, 0x36, 0x0d, 0x15, 0x45, 0x24, 0x5e, 0x1a, 0x24, 0x38, 0x51, 0x20,
+	0x9a, 0x68, 0x2f, 0x19, 0xd6, 0x2b, 0x7c, 0x37, 0x17, 0xef, 0xde, 0x29, 0xe9, 0x10, 0x25, 0x15,
+	0x12, 0x2d, 0x1f, 0x28, 0x65, 0x10, 0x0d, 0x15, 0x42, 0x36, 0x1f, 0x04, 0xdd, 0xee, 0x86, 0x84,
+	0x18, 0x29, 0xdd, 0xce, 0xfc, 0x67, 0x76, 0x7e, 0xf3, 0x42, 0x77, 0x75, 0x71, 0x0c, 0xa7, 0x30,
+	0x17, 0x39, 0xd6, 0x20, 0xea, 0xb1, 0x38, 0xa9, 0x60, 0x7e, 0xc6, 0xcb, 0x39, 0x5a, 0x64, 0xff,
+	0x07, 0x91, 0x37, 0x22, 0xaf, 0xc7, 0xfd, 0xfb, 0x47, 0x68, 0x72, 0x34, 0x22, 0x93, 0x06, 0x7c,
+	0xa4, 0xa8, 0xc7, 0x19, 0x58, 0x39, 0x16, 0xa5, 0x54, 0xba, 0x90, 0x56, 0x63, 0xe1, 0x93, 0xfb,
+	0x77, 0x14, 0x2a, 0x74, 0x4f, 0xd1, 0xbc, 0x82, 0xf7, 0xae, 0x42, 0x54, 0x33, 0x10, 0xb2, 0xd4,
+	0x42, 0x16, 0x05, 0x5a, 0x97, 0x62, 0x82, 0xba, 0x42, 0x63, 0xcf, 0x4a, 0x08, 0xe2, 0xe8, 0x2b,
+	0xa1, 0x5b, 0x2f, 0x9b, 0x9a, 0xcf, 0xb1, 0x86, 0xc7, 0x35, 0x14, 0xd6, 0xa4, 0x70, 0x52, 0x81,
+	0xb1, 0x6c, 0x87, 0x6e, 0x34, 0x91, 0x87, 0x56, 0xaa, 0x88, 0x0c, 0x49, 0xb2, 0x99, 0xfe, 0xd7,
+	0xd8, 0xaf, 0xa4, 0x62, 0x5b, 0xb4, 0x9b, 0xe3, 0x71, 0x35, 0x83, 0xa8, 0xed, 0x84, 0x60, 0xb1,
+	0x01, 0xed, 0xbd, 0x9d, 0x63, 0x7e, 0x38, 0x05, 0xad, 0xa6, 0x36, 0xea, 0x0c, 0x49, 0xd2, 0x49,
+	0x69, 0xe3, 0x7a, 0xe6, 0x3c, 0x6c, 0x97, 0x6e, 0x5a, 0xbc, 0x94, 0xd7, 0x9c, 0xbc, 0x61, 0x31,
+	0x88, 0x4f, 0x28, 0xbd, 0x6a, 0x38, 0x5a, 0x1f, 0x92, 0xa4, 0x37, 0xb9, 0xc7, 0xfd, 0x74, 0x78,
+	0x33, 0x1d, 0xee, 0xe7, 0x18, 0xa6, 0xc3, 0x5f, 0x48, 0x05, 0x01, 0x36, 0xbd, 0x96, 0x39, 0xfa,
+	0x42, 0xe8, 0xf6, 0x4a, 0x4f, 0xa6, 0xc4, 0xc2, 0x00, 0x7b, 0x48, 0xbb, 0xe0, 0x3c, 0x11, 0x19,
+	0x76, 0x92, 0xde, 0xa4, 0xcf, 0x6f, 0xac, 0x83, 0xff, 0x49, 0x3a, 0x58, 0x3b, 0xff, 0x31, 0x68,
+	0xa5, 0x21, 0x9e, 0x3d, 0xfd, 0x8b, 0xae, 0xed, 0xe8, 0xf6, 0x6e, 0xa5, 0xf3, 0x65, 0xaf, 0xe3,
+	0x4d, 0x3e, 0x12, 0xba, 0xee, 0xf0, 0xd8, 0x7b, 0x42, 0xe9, 0x15, 0x23, 0xdb, 0x5b, 0x61, 0xf9,
+	0xf7, 0x66, 0xfa, 0xc9, 0xed, 0x81, 0xbe, 0xee, 0x68, 0xf0, 0xe1, 0xdb, 0xaf, 0xcf, 0xed, 0x1d,
+	0xb6, 0x2d, 0x6e, 0x1e, 0x81, 0xef, 0xea, 0xe0, 0xf5, 0xf9, 0x22, 0x26, 0x17, 0x8b, 0x98, 0xfc,
+	0x5c, 0xc4, 0xe4, 0xd3, 0x32, 0x6e, 0x5d, 0x2c, 0xe3, 0xd6, 0xf7, 0x65, 0xdc, 0x7a, 0xf3, 0x48,
+	0x69, 0x3b, 0xad, 0x32, 0x7e, 0x84, 0xb9, 0xd0, 0x85, 0xb6, 0x5a, 0xee, 0xcf, 0x64, 0x66, 0xc4,
+	0xbb, 0xfa, 0xf2, 0x2b, 0x53, 0x65, 0x7e, 0xef, 0xc6, 0xfd, 0xba, 0x6f, 0x4f, 0xfd, 0x69, 0x39,
+	0x2b, 0xeb, 0xba, 0x03, 0x7b, 0xf0, 0x7b, 0x00, 0xaa, 0x78, 0x1e, 0x54, 0x0d, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MoveEvents queries the move events by the type tag or by the module
+	MoveEvents(ctx context.Context, in *QueryMoveEventsRequest, opts ...grpc.CallOption) (*QueryMoveEventsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MoveEvents(ctx context.Context, in *QueryMoveEventsRequest, opts ...grpc.CallOption) (*QueryMoveEventsResponse, error) {
+	out := new(QueryMoveEventsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.move.v1.Query/MoveEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MoveEvents queries the move events by the type tag or by the module
+	MoveEvents(context.Context, *QueryMoveEventsRequest) (*QueryMoveEventsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MoveEvents(ctx context.Context, req *QueryMoveEventsRequest) (*QueryMoveEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveEvents not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MoveEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMoveEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MoveEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.move.v1.Query/MoveEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MoveEvents(ctx, req.(*QueryMoveEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "indexer.move.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MoveEvents",
+			Handler:    _Query_MoveEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "indexer/move/v1/query.proto",
+}
+
+func (m *QueryMoveEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMoveEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMoveEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeTag) > 0 {
+		i -= len(m.TypeTag)
+		copy(dAtA[i:], m.TypeTag)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeTag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMoveEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMoveEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMoveEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMoveEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeTag)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMoveEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMoveEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMoveEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMoveEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMoveEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMoveEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMoveEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, MoveEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
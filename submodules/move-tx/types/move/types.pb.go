@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: indexer/move/v1/types.proto
+
+package move
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MoveEvent defines an event emitted by a Move module
+type MoveEvent struct {
+	// type_tag is the type of the event as emitted, e.g.
+	// 0x1::fungible_asset::DepositEvent
+	TypeTag string `protobuf:"bytes,1,opt,name=type_tag,json=typeTag,proto3" json:"type_tag,omitempty"`
+	// data is the JSON encoded event data
+	Data   string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// tx_index is the index of the tx in the block
+	TxIndex uint32 `protobuf:"varint,5,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// event_index is the index of the move event in the tx
+	EventIndex uint32 `protobuf:"varint,6,opt,name=event_index,json=eventIndex,proto3" json:"event_index,omitempty"`
+}
+
+func (m *MoveEvent) Reset()         { *m = MoveEvent{} }
+func (m *MoveEvent) String() string { return proto.CompactTextString(m) }
+func (*MoveEvent) ProtoMessage()    {}
+func (*MoveEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c62568a00f1f81e0, []int{0}
+}
+func (m *MoveEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveEvent.Merge(m, src)
+}
+func (m *MoveEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveEvent proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MoveEvent)(nil), "indexer.move.v1.MoveEvent")
+}
+
+func init() { proto.RegisterFile("indexer/move/v1/types.proto", fileDescriptor_c62568a00f1f81e0) }
+
+var fileDescriptor_c62568a00f1f81e0 = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0xc1, 0x4a, 0x33, 0x31,
+	0x10, 0xc7, 0x37, 0x5f, 0xfb, 0x6d, 0x6d, 0x44, 0x84, 0x20, 0xba, 0x2a, 0xc4, 0xe2, 0xa9, 0x97,
+	0x6e, 0x28, 0xde, 0x3d, 0x08, 0x82, 0x1e, 0xbc, 0x14, 0x4f, 0x5e, 0x6a, 0xd6, 0x86, 0x24, 0xd8,
+	0x36, 0xa5, 0x99, 0x86, 0xf8, 0x16, 0xbe, 0x85, 0x3e, 0x4a, 0x8f, 0x3d, 0x7a, 0xd4, 0xed, 0x8b,
+	0x48, 0xa6, 0xeb, 0x6d, 0xfe, 0xf3, 0xfb, 0x33, 0x0c, 0x3f, 0x7a, 0x6e, 0xe7, 0x13, 0x15, 0xd5,
+	0x52, 0xcc, 0x5c, 0x50, 0x22, 0x0c, 0x05, 0xbc, 0x2d, 0x94, 0x2f, 0x17, 0x4b, 0x07, 0x8e, 0x1d,
+	0x36, 0xb0, 0x4c, 0xb0, 0x0c, 0xc3, 0xb3, 0x23, 0xed, 0xb4, 0x43, 0x26, 0xd2, 0xb4, 0xab, 0x5d,
+	0x7e, 0x10, 0xda, 0x7d, 0x70, 0x41, 0xdd, 0x06, 0x35, 0x07, 0x76, 0x4a, 0xf7, 0xd2, 0x8d, 0x31,
+	0x48, 0x5d, 0x90, 0x1e, 0xe9, 0x77, 0x47, 0x9d, 0x94, 0x1f, 0xa5, 0x66, 0x8c, 0xb6, 0x27, 0x12,
+	0x64, 0xf1, 0x0f, 0xd7, 0x38, 0xb3, 0x63, 0x9a, 0x1b, 0x65, 0xb5, 0x81, 0xa2, 0xd5, 0x23, 0xfd,
+	0xd6, 0xa8, 0x49, 0xec, 0x84, 0x76, 0x20, 0x8e, 0x8d, 0xf4, 0xa6, 0x68, 0x63, 0x3d, 0x87, 0x78,
+	0x27, 0xbd, 0xc1, 0xfb, 0x71, 0x8c, 0x9f, 0x15, 0xff, 0x7b, 0xa4, 0x7f, 0x30, 0xea, 0x40, 0xbc,
+	0x4f, 0x91, 0x5d, 0xd0, 0x7d, 0x95, 0x7e, 0x68, 0x68, 0x8e, 0x94, 0xe2, 0x0a, 0x0b, 0x37, 0xcf,
+	0xeb, 0x1f, 0x9e, 0x7d, 0xd6, 0x9c, 0xac, 0x6b, 0x4e, 0x36, 0x35, 0x27, 0xdf, 0x35, 0x27, 0xef,
+	0x5b, 0x9e, 0x6d, 0xb6, 0x3c, 0xfb, 0xda, 0xf2, 0xec, 0xe9, 0x5a, 0x5b, 0x30, 0xab, 0xaa, 0x7c,
+	0x71, 0x33, 0x61, 0xe7, 0x16, 0xac, 0x1c, 0x4c, 0x65, 0xe5, 0xc5, 0x6b, 0xf8, 0x13, 0xe5, 0x57,
+	0xd5, 0xcc, 0x4d, 0x56, 0x53, 0xe5, 0xd1, 0xd9, 0x00, 0xe2, 0xce, 0x19, 0xa6, 0x2a, 0x47, 0x25,
+	0x57, 0xbf, 0x03, 0x00, 0x70, 0xaa, 0xa3, 0x9c, 0x58, 0x01, 0x00, 0x00,
+}
+
+func (this *MoveEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MoveEvent)
+	if !ok {
+		that2, ok := that.(MoveEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TypeTag != that1.TypeTag {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	if this.TxIndex != that1.TxIndex {
+		return false
+	}
+	if this.EventIndex != that1.EventIndex {
+		return false
+	}
+	return true
+}
+func (m *MoveEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeTag) > 0 {
+		i -= len(m.TypeTag)
+		copy(dAtA[i:], m.TypeTag)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TypeTag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MoveEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeTag)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	if m.EventIndex != 0 {
+		n += 1 + sovTypes(uint64(m.EventIndex))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MoveEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventIndex", wireType)
+			}
+			m.EventIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
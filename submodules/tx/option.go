@@ -0,0 +1,34 @@
+package tx
+
+import (
+	txindexer "github.com/initia-labs/kvindexer/tx"
+)
+
+// Option configures the TxSubmodule
+type Option = txindexer.Option
+
+// AddressExtractor returns the addresses found in the value of an event attribute
+type AddressExtractor = txindexer.AddressExtractor
+
+// DefaultEventIndexAllowlist is the default set of the event attributes indexed for TxsByEvents
+var DefaultEventIndexAllowlist = txindexer.DefaultEventIndexAllowlist
+
+// WithEventIndexAllowlist sets the event attributes indexed for TxsByEvents
+func WithEventIndexAllowlist(allowlist ...string) Option {
+	return txindexer.WithEventIndexAllowlist(allowlist...)
+}
+
+// WithAddressExtractor sets the extractor finding the account addresses in the attributes of the event
+func WithAddressExtractor(eventType, key string, extractor AddressExtractor) Option {
+	return txindexer.WithAddressExtractor(eventType, key, extractor)
+}
+
+// GrepAddressExtractor greps the addresses in the whole value
+func GrepAddressExtractor(value string, grep func(string) []string) []string {
+	return txindexer.GrepAddressExtractor(value, grep)
+}
+
+// JSONAddressExtractor returns an AddressExtractor grepping the string values of the given fields of the JSON value
+func JSONAddressExtractor(fields ...string) AddressExtractor {
+	return txindexer.JSONAddressExtractor(fields...)
+}
@@ -5,17 +5,5 @@ const (
 	SubmoduleName = "tx"
 
 	// Version is the current version of the submodule
-	Version = "v0.2.2"
-)
-
-// store prefixes
-const (
-	TxsByAccountPrefix            = 0x10
-	AccountSequencePrefix         = 0x20
-	SequencePrefix                = 0xa0
-	TxSequencePrefix              = 0xb0
-	TxByHeightPrefix              = 0xc0
-	TxsPrefix                     = 0xf0
-	SequenceByHeightPrefix        = 0xd0
-	AccountSequenceByHeightPrefix = 0xe0
+	Version = "v0.3.0"
 )
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"strconv"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -23,9 +24,17 @@ var (
 	qreqCollectionNumTokens    = []byte("{\"num_tokens\":{}}")    // {"num_tokens":{}}
 )
 
-func generateQueryRequestToGetNftInfo(tokenId string) []byte {
-	return []byte(`{"nft_info":{"token_id":"` + tokenId + `"}}`)
-	//return encode(qb)
+// tokenQuery is the query message of a token, whose id is any string set by the contract
+type tokenQuery struct {
+	TokenId string `json:"token_id"`
+}
+
+func generateQueryRequestToGetNftInfo(tokenId string) ([]byte, error) {
+	return json.Marshal(map[string]tokenQuery{"nft_info": {TokenId: tokenId}}) // {"nft_info":{"token_id":"..."}}
+}
+
+func generateQueryRequestToGetOwnerOf(tokenId string) ([]byte, error) {
+	return json.Marshal(map[string]tokenQuery{"owner_of": {TokenId: tokenId}}) // {"owner_of":{"token_id":"..."}}
 }
 
 func (sm WasmNFTSubmodule) getCollectionContractInfo(ctx context.Context, colAddr sdk.AccAddress) (*types.ContractInfo, error) {
@@ -106,7 +115,10 @@ func (sm WasmNFTSubmodule) getIndexedCollectionFromVMStore(ctx context.Context,
 func (sm WasmNFTSubmodule) getNftResourceFromVMStore(ctx context.Context, collectionAddr sdk.AccAddress, tokenId string) (*types.NftResource, error) {
 	resource := types.NftResource{}
 
-	q := generateQueryRequestToGetNftInfo(tokenId)
+	q, err := generateQueryRequestToGetNftInfo(tokenId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	rb, err := sm.vmKeeper.QuerySmart(ctx, collectionAddr, q)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -138,6 +150,48 @@ func (sm WasmNFTSubmodule) getIndexedNftFromVMStore(ctx context.Context, contrac
 	return &indexed, nil
 }
 
+func (sm WasmNFTSubmodule) getNftOwnerFromVMStore(ctx context.Context, collectionAddr sdk.AccAddress, tokenId string) (sdk.AccAddress, error) {
+	q, err := generateQueryRequestToGetOwnerOf(tokenId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rb, err := sm.vmKeeper.QuerySmart(ctx, collectionAddr, q)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := types.OwnerOf{}
+	if err := json.Unmarshal(rb, &res); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return sm.ac.StringToBytes(res.Owner)
+}
+
+// getAuthoritativeToken returns the token and its owner from the VM store
+func (sm WasmNFTSubmodule) getAuthoritativeToken(ctx context.Context, key collections.Pair[sdk.AccAddress, string], _ nfttypes.IndexedToken) (*nfttypes.Token, sdk.AccAddress, error) {
+	resource, err := sm.getNftResourceFromVMStore(ctx, key.K1(), key.K2())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownerAddr, err := sm.getNftOwnerFromVMStore(ctx, key.K1(), key.K2())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &nfttypes.Token{TokenId: key.K2(), Uri: resource.TokenUri}, ownerAddr, nil
+}
+
+// getAuthoritativeCollection returns the collection from the VM store
+func (sm WasmNFTSubmodule) getAuthoritativeCollection(ctx context.Context, collectionAddr sdk.AccAddress) (*nfttypes.Collection, error) {
+	resource, err := sm.getCollectionFromVMStore(ctx, collectionAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resource.Collection, nil
+}
+
 // wasm only uses bech32 address, not hex
 func getVMAddress(_ address.Codec, addr string) (sdk.AccAddress, error) {
 	return sdk.AccAddressFromBech32(addr)
@@ -0,0 +1,39 @@
+package wasm_nft
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGenerateTokenQueryRequests(t *testing.T) {
+	tests := []struct {
+		name    string
+		tokenId string
+	}{
+		{"plain", "1"},
+		{"quote", `a"b`},
+		{"backslash", `a\b`},
+		{"injected field", `1"},"owner_of":{"token_id":"2`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for msg, generate := range map[string]func(string) ([]byte, error){
+				"nft_info": generateQueryRequestToGetNftInfo,
+				"owner_of": generateQueryRequestToGetOwnerOf,
+			} {
+				q, err := generate(tc.tokenId)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var decoded map[string]tokenQuery
+				if err = json.Unmarshal(q, &decoded); err != nil {
+					t.Fatalf("invalid %s query %s: %v", msg, q, err)
+				}
+				if len(decoded) != 1 || decoded[msg].TokenId != tc.tokenId {
+					t.Errorf("got %s query %s, want token id %s", msg, q, tc.tokenId)
+				}
+			}
+		})
+	}
+}
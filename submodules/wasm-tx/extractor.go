@@ -0,0 +1,190 @@
+package tx
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	txindexer "github.com/initia-labs/kvindexer/tx"
+)
+
+const (
+	eventTypeWasm        = "wasm"
+	eventTypeInstantiate = "instantiate"
+	eventTypeExecute     = "execute"
+
+	// customEventTypePrefix is the prefix of the event types emitted by the contracts
+	customEventTypePrefix = "wasm-"
+
+	// maxPayloadDepth limits the depth of the base64 encoded JSON payloads decoded,
+	// e.g. the msg of the cw20 send nested in the msg of MsgExecuteContract
+	maxPayloadDepth = 4
+
+	// coinDenomField is the field number of the denom of cosmos.base.v1beta1.Coin
+	coinDenomField protowire.Number = 1
+)
+
+// wasmMsgFields are the field numbers of the wasm messages to be extracted. The messages are
+// parsed in the wire format so that the submodule doesn't depend on wasmd.
+type wasmMsgFields struct {
+	// addresses are the sender, the admin and the contract address fields
+	addresses []protowire.Number
+	// payload is the JSON message to the contract
+	payload protowire.Number
+	// funds are the coins sent to the contract, whose denoms may include the addresses
+	// (e.g. the tokenfactory denoms). zero if the message has no funds.
+	funds protowire.Number
+}
+
+var wasmMsgs = map[string]wasmMsgFields{
+	"cosmwasm.wasm.v1.MsgExecuteContract":      {addresses: []protowire.Number{1, 2}, payload: 3, funds: 5},
+	"cosmwasm.wasm.v1.MsgInstantiateContract":  {addresses: []protowire.Number{1, 2}, payload: 5, funds: 6},
+	"cosmwasm.wasm.v1.MsgInstantiateContract2": {addresses: []protowire.Number{1, 2}, payload: 5, funds: 6},
+	"cosmwasm.wasm.v1.MsgMigrateContract":      {addresses: []protowire.Number{1, 2}, payload: 4},
+	"cosmwasm.wasm.v1.MsgSudoContract":         {addresses: []protowire.Number{1, 2}, payload: 3},
+}
+
+var _ txindexer.AccountExtractor = wasmAccountExtractor{}
+var _ txindexer.MsgAccountExtractor = wasmAccountExtractor{}
+
+// wasmAccountExtractor finds the accounts in the attributes of the wasm events and in the
+// payloads of the wasm messages, including the base64 encoded JSON nested in them
+type wasmAccountExtractor struct {
+	grep func(string) []string
+}
+
+// ExtractAddresses implements txindexer.AccountExtractor.
+func (e wasmAccountExtractor) ExtractAddresses(eventType, key, value string) ([]string, bool) {
+	if !isWasmEvent(eventType) {
+		return nil, false
+	}
+	return e.grepPayload(value, 0), true
+}
+
+// ExtractRoles implements txindexer.AccountExtractor. The roles are found by the attribute keys
+// as the other events, e.g. from and to of the cw20 transfers.
+func (wasmAccountExtractor) ExtractRoles(eventType, key, value string) ([]txindexer.AccountRole, bool) {
+	return nil, false
+}
+
+// ExtractMsgAddresses implements txindexer.MsgAccountExtractor.
+func (e wasmAccountExtractor) ExtractMsgAddresses(typeURL string, value []byte) []string {
+	fields, found := wasmMsgs[typeURL[strings.LastIndex(typeURL, "/")+1:]]
+	if !found {
+		return nil
+	}
+
+	addrs := []string{}
+	rangeBytesFields(value, func(num protowire.Number, bz []byte) {
+		switch {
+		case slices.Contains(fields.addresses, num):
+			addrs = append(addrs, string(bz))
+		case num == fields.payload:
+			addrs = append(addrs, e.grepPayload(string(bz), 0)...)
+		case fields.funds != 0 && num == fields.funds:
+			rangeBytesFields(bz, func(num protowire.Number, denom []byte) {
+				if num == coinDenomField {
+					addrs = append(addrs, e.grep(string(denom))...)
+				}
+			})
+		}
+	})
+
+	return addrs
+}
+
+// grepPayload greps the addresses in the value and in the base64 encoded JSON found in it
+func (e wasmAccountExtractor) grepPayload(value string, depth int) []string {
+	addrs := e.grep(value)
+	if depth >= maxPayloadDepth {
+		return addrs
+	}
+
+	if decoded, ok := decodeBase64JSON(value); ok {
+		return append(addrs, e.grepPayload(decoded, depth+1)...)
+	}
+
+	var payload any
+	if err := json.Unmarshal([]byte(value), &payload); err != nil {
+		return addrs
+	}
+
+	for _, str := range jsonStrings(payload) {
+		if decoded, ok := decodeBase64JSON(str); ok {
+			addrs = append(addrs, e.grepPayload(decoded, depth+1)...)
+		}
+	}
+
+	return addrs
+}
+
+// isWasmEvent checks if the event is emitted by the wasm module or by a contract
+func isWasmEvent(eventType string) bool {
+	switch eventType {
+	case eventTypeWasm, eventTypeInstantiate, eventTypeExecute:
+		return true
+	default:
+		return strings.HasPrefix(eventType, customEventTypePrefix)
+	}
+}
+
+// decodeBase64JSON decodes the base64 encoded JSON, such as the Binary of the contract messages
+func decodeBase64JSON(str string) (string, bool) {
+	bz, err := base64.StdEncoding.DecodeString(str)
+	if err != nil || !json.Valid(bz) {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// jsonStrings returns the string values of the JSON at any depth
+func jsonStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		strs := []string{}
+		for _, elem := range v {
+			strs = append(strs, jsonStrings(elem)...)
+		}
+		return strs
+	case map[string]any:
+		strs := []string{}
+		for _, elem := range v {
+			strs = append(strs, jsonStrings(elem)...)
+		}
+		return strs
+	default:
+		return nil
+	}
+}
+
+// rangeBytesFields calls fn with the length-delimited fields of the message in the wire format.
+// It stops at the first malformed field.
+func rangeBytesFields(bz []byte, fn func(num protowire.Number, value []byte)) {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return
+		}
+		bz = bz[n:]
+
+		if typ != protowire.BytesType {
+			if n = protowire.ConsumeFieldValue(num, typ, bz); n < 0 {
+				return
+			}
+			bz = bz[n:]
+			continue
+		}
+
+		value, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return
+		}
+		fn(num, value)
+		bz = bz[n:]
+	}
+}
@@ -0,0 +1,82 @@
+package tx
+
+import (
+	"encoding/base64"
+	"slices"
+	"testing"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	txindexer "github.com/initia-labs/kvindexer/tx"
+)
+
+// appendBytesField appends the length-delimited field to the message in the wire format
+func appendBytesField(bz []byte, num protowire.Number, value []byte) []byte {
+	bz = protowire.AppendTag(bz, num, protowire.BytesType)
+	return protowire.AppendBytes(bz, value)
+}
+
+func TestExtractMsgAddresses(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("init")
+	grep, err := txindexer.NewAddressGrep(ac)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := wasmAccountExtractor{grep}
+
+	addrs := make([]string, 5)
+	for i := range addrs {
+		if addrs[i], err = ac.BytesToString(sdk.AccAddress(append(make([]byte, 19), byte(i+1)))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sender, contract, recipient, nested, denomCreator := addrs[0], addrs[1], addrs[2], addrs[3], addrs[4]
+
+	// the cw20 send whose msg to the recipient contract is base64 encoded JSON
+	cw20Send := `{"send":{"contract":"` + recipient + `","amount":"1","msg":"` +
+		base64.StdEncoding.EncodeToString([]byte(`{"owner":"`+nested+`"}`)) + `"}}`
+	coin := appendBytesField(nil, coinDenomField, []byte("factory/"+denomCreator+"/utoken"))
+	coin = appendBytesField(coin, 2, []byte("1"))
+
+	execute := appendBytesField(nil, 1, []byte(sender))
+	execute = appendBytesField(execute, 2, []byte(contract))
+	execute = appendBytesField(execute, 3, []byte(cw20Send))
+	execute = appendBytesField(execute, 5, coin)
+
+	// the code id of MsgInstantiateContract is a varint field
+	instantiate := appendBytesField(nil, 1, []byte(sender))
+	instantiate = protowire.AppendTag(instantiate, 3, protowire.VarintType)
+	instantiate = protowire.AppendVarint(instantiate, 1)
+	instantiate = appendBytesField(instantiate, 4, []byte("label "+nested))
+	instantiate = appendBytesField(instantiate, 5, []byte(`{"minter":"`+recipient+`"}`))
+
+	// the length of the last field exceeds the message
+	malformed := appendBytesField(nil, 1, []byte(sender))
+	malformed = protowire.AppendTag(malformed, 2, protowire.BytesType)
+	malformed = protowire.AppendVarint(malformed, 100)
+
+	tests := []struct {
+		name    string
+		typeURL string
+		value   []byte
+		want    []string
+	}{
+		{"execute contract", "/cosmwasm.wasm.v1.MsgExecuteContract", execute, []string{sender, contract, recipient, nested, denomCreator}},
+		{"instantiate contract skips the label", "/cosmwasm.wasm.v1.MsgInstantiateContract", instantiate, []string{sender, recipient}},
+		{"malformed message", "/cosmwasm.wasm.v1.MsgExecuteContract", malformed, []string{sender}},
+		{"not a wasm message", "/cosmos.bank.v1beta1.MsgSend", execute, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := e.ExtractMsgAddresses(tc.typeURL, tc.value)
+			slices.Sort(got)
+			want := slices.Clone(tc.want)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package tx
+
+import (
+	txindexer "github.com/initia-labs/kvindexer/tx"
+)
+
+// Option configures the WasmTxSubmodule
+type Option = txindexer.Option
+
+// AddressExtractor returns the addresses found in the value of an event attribute
+type AddressExtractor = txindexer.AddressExtractor
+
+// DefaultEventIndexAllowlist is the default set of the event attributes indexed for TxsByEvents
+var DefaultEventIndexAllowlist = txindexer.DefaultEventIndexAllowlist
+
+// WithEventIndexAllowlist sets the event attributes indexed for TxsByEvents
+func WithEventIndexAllowlist(allowlist ...string) Option {
+	return txindexer.WithEventIndexAllowlist(allowlist...)
+}
+
+// WithAddressExtractor sets the extractor finding the account addresses in the attributes of the event.
+// The attributes of the wasm events are always extracted by the submodule.
+func WithAddressExtractor(eventType, key string, extractor AddressExtractor) Option {
+	return txindexer.WithAddressExtractor(eventType, key, extractor)
+}
@@ -0,0 +1,37 @@
+package tx
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/initia-labs/kvindexer/collection"
+	"github.com/initia-labs/kvindexer/submodules/wasm-tx/types"
+	txindexer "github.com/initia-labs/kvindexer/tx"
+	kvindexer "github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+var _ kvindexer.Submodule = WasmTxSubmodule{}
+var _ kvindexer.Verifier = WasmTxSubmodule{}
+
+// WasmTxSubmodule indexes the txs with the accounts found in the wasm events and the
+// payloads of the contract messages, in addition to the cosmos events and messages
+type WasmTxSubmodule struct {
+	txindexer.Indexer
+}
+
+func NewTxSubmodule(
+	cdc codec.Codec,
+	indexerKeeper collection.IndexerKeeper,
+	opts ...Option,
+) (*WasmTxSubmodule, error) {
+	grep, err := txindexer.NewAddressGrep(indexerKeeper.GetAddressCodec())
+	if err != nil {
+		return nil, err
+	}
+
+	indexer, err := txindexer.NewIndexer(types.SubmoduleName, types.Version, cdc, indexerKeeper, wasmAccountExtractor{grep}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WasmTxSubmodule{*indexer}, nil
+}
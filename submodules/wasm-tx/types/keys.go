@@ -0,0 +1,9 @@
+package types
+
+const (
+	// SubmoduleName is the name of the submodule
+	SubmoduleName = "wasm-tx"
+
+	// Version is the current version of the submodule
+	Version = "v0.1.0"
+)
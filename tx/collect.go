@@ -15,12 +15,10 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
-
-	"github.com/initia-labs/kvindexer/submodules/tx/types"
 )
 
-func (sm TxSubmodule) finalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
-	sm.Logger(ctx).Debug("finalizeBlock", "submodule", types.SubmoduleName, "txs", len(req.Txs), "height", req.Height)
+func (sm Indexer) finalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	sm.Logger(ctx).Debug("finalizeBlock", "submodule", sm.name, "txs", len(req.Txs), "height", req.Height)
 
 	if err := sm.processTxs(ctx, req, res); err != nil {
 		return err
@@ -29,11 +27,11 @@ func (sm TxSubmodule) finalizeBlock(ctx context.Context, req abci.RequestFinaliz
 	return nil
 }
 
-func (sm TxSubmodule) processTxs(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+func (sm Indexer) processTxs(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
 	// key: address, value: txs slice
 	accTxMap := map[string][]string{}
 
-	txHashes := []string{}
+	txs := []txIndex{}
 	for idx, txBytes := range req.Txs {
 		tx, err := parseTx(sm.cdc, txBytes)
 		if err != nil {
@@ -63,8 +61,8 @@ func (sm TxSubmodule) processTxs(ctx context.Context, req abci.RequestFinalizeBl
 			continue
 		}
 
-		// get addresses from the tx
-		addrs, err := grepAddressesFromTx(txr)
+		// get addresses from the events and the messages of the tx
+		addrs, err := sm.grepAccounts(txr, tx)
 		if err != nil {
 			sm.Logger(ctx).Info("failed to grep addresses from tx", "error", err, "index", idx)
 			continue
@@ -73,18 +71,26 @@ func (sm TxSubmodule) processTxs(ctx context.Context, req abci.RequestFinalizeBl
 		for _, addr := range addrs {
 			accTxMap[addr] = uniqueAppend(accTxMap[addr], txHashStr)
 		}
-		txHashes = append(txHashes, txHashStr)
+		txIdx := sm.newTxIndex(txHashStr, txr, tx, addrs)
+		txIdx.txBytes = txBytes
+		txIdx.blockIndex = uint32(idx)
+		txs = append(txs, txIdx)
+	}
+
+	txsByHash := map[string]txIndex{}
+	for _, txIdx := range txs {
+		txsByHash[txIdx.hash] = txIdx
 	}
 
 	// store tx/account pair into txAccMap
 	for addr, txHashes := range accTxMap {
-		err := sm.storeAccTxs(ctx, req.Height, addr, txHashes)
+		err := sm.storeAccTxs(ctx, req.Height, req.Time, addr, txHashes, txsByHash)
 		if err != nil {
 			sm.Logger(ctx).Info("failed to store tx/account pair", "error", err, "address", addr)
 		}
 	}
 
-	return sm.storeIndices(ctx, req.Height, txHashes)
+	return sm.storeIndices(ctx, req.Height, req.Time, txs)
 }
 
 func uniqueAppend(slice []string, elem string) []string {
@@ -105,18 +111,20 @@ func parseTx(cdc codec.Codec, txBytes []byte) (*tx.Tx, error) {
 	return &tx, nil
 }
 
-func grepAddressesFromTx(txr *sdk.TxResponse) ([]string, error) {
+func (sm Indexer) grepAddressesFromTx(txr *sdk.TxResponse) ([]string, error) {
 	grepped := []string{}
 	for _, event := range txr.Events {
 		for _, attr := range event.Attributes {
-			addrs := findAllBech32Address(attr.Value)
-			addrs = append(addrs, findAllHexAddress(attr.Value)...)
+			addrs := sm.extractAddresses(event.Type, attr.Key, attr.Value)
 			for _, addr := range addrs {
-				accAddr, err := accAddressFromString(addr)
+				accAddr, err := sm.parseAddress(addr)
 				if err != nil {
 					continue
 				}
-				grepped = append(grepped, accAddr.String())
+				if addr, err = sm.ac.BytesToString(accAddr); err != nil {
+					continue
+				}
+				grepped = append(grepped, addr)
 			}
 		}
 	}
@@ -124,15 +132,16 @@ func grepAddressesFromTx(txr *sdk.TxResponse) ([]string, error) {
 	return grepped, nil
 }
 
-func (sm TxSubmodule) storeAccTxs(ctx context.Context, height int64, addr string, txHashes []string) error {
+func (sm Indexer) storeAccTxs(ctx context.Context, height int64, blockTime time.Time, addr string, txHashes []string, txsByHash map[string]txIndex) error {
 	if len(txHashes) == 0 {
 		return nil
 	}
-	acc, err := sdk.AccAddressFromBech32(addr)
+	bz, err := sm.ac.StringToBytes(addr)
 	if err != nil {
 		sm.Logger(ctx).Info("failed to convert address", "error", err, "address", addr)
 		return err
 	}
+	acc := sdk.AccAddress(bz)
 
 	seq, err := sm.accountSequenceMap.Get(ctx, acc)
 	if err != nil && !cosmoserr.IsOf(err, collections.ErrNotFound) {
@@ -153,15 +162,24 @@ func (sm TxSubmodule) storeAccTxs(ctx context.Context, height int64, addr string
 		return err
 	}
 
+	// store (account, height) -> sequence for height range filters
+	if err = sm.accountHeightSequenceMap.Set(ctx, collections.Join(acc, height), delta); err != nil {
+		return err
+	}
+
 	// store (height, account, sequence) for pruning
-	return sm.accountSequenceByHeightMap.Set(ctx, collections.Join3(height, acc, delta), true)
+	if err = sm.accountSequenceByHeightMap.Set(ctx, collections.Join3(height, acc, delta), true); err != nil {
+		return err
+	}
+
+	return sm.updateAccountSummary(ctx, acc, height, blockTime, txHashes, txsByHash)
 }
 
-func (sm TxSubmodule) storeIndices(ctx context.Context, height int64, txHashes []string) error {
-	for i, txHash := range txHashes {
-		err := sm.txhashesByHeightMap.Set(ctx, collections.Join(height, uint64(i)), txHash)
+func (sm Indexer) storeIndices(ctx context.Context, height int64, blockTime time.Time, txs []txIndex) error {
+	for i, txIdx := range txs {
+		err := sm.txhashesByHeightMap.Set(ctx, collections.Join(height, uint64(i)), txIdx.hash)
 		if err != nil {
-			sm.Logger(ctx).Info("failed to store tx/height pair", "error", err, "height", height, "txhash", txHash)
+			sm.Logger(ctx).Info("failed to store tx/height pair", "error", err, "height", height, "txhash", txIdx.hash)
 			continue
 		}
 
@@ -170,10 +188,14 @@ func (sm TxSubmodule) storeIndices(ctx context.Context, height int64, txHashes [
 			return err
 		}
 
-		err = sm.txhashesBySequenceMap.Set(ctx, seq, txHash)
+		err = sm.txhashesBySequenceMap.Set(ctx, seq, txIdx.hash)
 		if err != nil {
 			return err
 		}
+
+		if err = sm.storeTxIndices(ctx, seq, txIdx); err != nil {
+			sm.Logger(ctx).Info("failed to store tx indices", "error", err, "height", height, "txhash", txIdx.hash)
+		}
 	}
 
 	// store height -> sequence for pruning
@@ -182,5 +204,10 @@ func (sm TxSubmodule) storeIndices(ctx context.Context, height int64, txHashes [
 		return err
 	}
 
+	// store time -> height for time range filters
+	if err = sm.heightByTimeMap.Set(ctx, blockTime, height); err != nil {
+		return err
+	}
+
 	return sm.sequenceByHeightMap.Set(ctx, height, seq)
 }
@@ -0,0 +1,92 @@
+package tx
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+
+	"github.com/initia-labs/kvindexer/tx/types"
+)
+
+// maxIndexedValueLength is the maximum length of the attribute value to be indexed.
+// longer values (e.g. json-encoded event data) are only matched by the query filter.
+const maxIndexedValueLength = 256
+
+// eventAllowlist is the set of the event types and "type.key" attributes to be indexed
+type eventAllowlist map[string]bool
+
+func newEventAllowlist(entries []string) eventAllowlist {
+	allowlist := eventAllowlist{}
+	for _, entry := range entries {
+		allowlist[entry] = true
+	}
+	return allowlist
+}
+
+func (l eventAllowlist) allows(eventType, key string) bool {
+	return l[eventType] || l[eventType+"."+key]
+}
+
+// eventIndexKeys returns the event attributes of the tx indexed in eventIndexMap
+func (sm Indexer) eventIndexKeys(txr *sdk.TxResponse) []types.EventIndexKey {
+	keys := []types.EventIndexKey{}
+	seen := map[[2]string]bool{}
+	for _, event := range txr.Events {
+		for _, attr := range event.Attributes {
+			if !sm.eventAllowlist.allows(event.Type, attr.Key) || len(attr.Value) > maxIndexedValueLength {
+				continue
+			}
+
+			tag := event.Type + "." + attr.Key
+			if seen[[2]string{tag, attr.Value}] {
+				continue
+			}
+			seen[[2]string{tag, attr.Value}] = true
+
+			keys = append(keys, types.EventIndexKey{Tag: tag, Value: attr.Value})
+		}
+	}
+	return keys
+}
+
+// indexedCondition returns the tag and the value of the first equality condition on an indexed attribute,
+// which is used to scan eventIndexMap. the other conditions are evaluated by matching the events of the txs.
+func (sm Indexer) indexedCondition(q *cmtquery.Query) (tag, value string, found bool) {
+	for _, cond := range q.Syntax() {
+		if cond.Op != syntax.TEq || cond.Arg == nil {
+			continue
+		}
+		if cond.Arg.Type != syntax.TString && cond.Arg.Type != syntax.TNumber {
+			continue
+		}
+
+		idx := strings.LastIndex(cond.Tag, ".")
+		if idx < 0 || !sm.eventAllowlist.allows(cond.Tag[:idx], cond.Tag[idx+1:]) {
+			continue
+		}
+
+		return cond.Tag, cond.Arg.Value(), true
+	}
+
+	return "", "", false
+}
+
+// flattenEvents returns the events of the tx in the form of the CometBFT query matcher,
+// including the reserved tx.hash and tx.height tags.
+func flattenEvents(txr *sdk.TxResponse) map[string][]string {
+	events := map[string][]string{
+		"tx.hash":   {strings.ToUpper(txr.TxHash)},
+		"tx.height": {strconv.FormatInt(txr.Height, 10)},
+	}
+	for _, event := range txr.Events {
+		for _, attr := range event.Attributes {
+			tag := event.Type + "." + attr.Key
+			events[tag] = append(events[tag], attr.Value)
+		}
+	}
+	return events
+}
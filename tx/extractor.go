@@ -0,0 +1,105 @@
+package tx
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressExtractor returns the addresses found in the value of an event attribute.
+// grep finds the bech32 and hex addresses in a string. The returned addresses
+// failing to be parsed as an account address are ignored.
+type AddressExtractor func(value string, grep func(string) []string) []string
+
+// GrepAddressExtractor greps the addresses in the whole value. It is used for the
+// attributes having no extractor configured.
+func GrepAddressExtractor(value string, grep func(string) []string) []string {
+	return grep(value)
+}
+
+// JSONAddressExtractor returns an AddressExtractor parsing the value as JSON and grepping
+// the string values of the given fields at any depth. All the string values are grepped
+// if no field is given. The value is grepped as a whole if it is not a valid JSON.
+func JSONAddressExtractor(fields ...string) AddressExtractor {
+	fieldSet := map[string]bool{}
+	for _, field := range fields {
+		fieldSet[field] = true
+	}
+
+	return func(value string, grep func(string) []string) []string {
+		var payload any
+		if err := json.Unmarshal([]byte(value), &payload); err != nil {
+			return grep(value)
+		}
+
+		var addrs []string
+		var walk func(v any, matched bool)
+		walk = func(v any, matched bool) {
+			switch v := v.(type) {
+			case string:
+				if matched {
+					addrs = append(addrs, grep(v)...)
+				}
+			case []any:
+				for _, elem := range v {
+					walk(elem, matched)
+				}
+			case map[string]any:
+				for key, elem := range v {
+					walk(elem, matched || fieldSet[key])
+				}
+			}
+		}
+		walk(payload, len(fieldSet) == 0)
+
+		return addrs
+	}
+}
+
+// AccountExtractor finds the accounts in the events specific to a VM. The variants of the tx
+// submodule implement it for their VM, and the rest of the indexing is shared.
+type AccountExtractor interface {
+	// ExtractAddresses returns the addresses found in the attribute of the event. handled is false
+	// if the attribute isn't specific to the VM, then the configured AddressExtractor is used instead.
+	ExtractAddresses(eventType, key, value string) (addrs []string, handled bool)
+	// ExtractRoles returns the roles of the accounts found in the attribute of the event. handled is
+	// false if the attribute isn't specific to the VM, then the roles are found by the attribute key.
+	ExtractRoles(eventType, key, value string) (roles []AccountRole, handled bool)
+}
+
+// MsgAccountExtractor is optionally implemented by the AccountExtractor to find the accounts in the
+// messages specific to a VM, e.g. in the JSON payload of a contract call, which are not annotated as
+// cosmos.AddressString. The returned addresses are added to the ones found in the address fields.
+type MsgAccountExtractor interface {
+	ExtractMsgAddresses(typeURL string, value []byte) []string
+}
+
+// extractAddresses returns the addresses found in the attribute by the account extractor of the VM,
+// or by the extractor configured for the event type and the attribute key, or for the event type.
+func (sm Indexer) extractAddresses(eventType, key, value string) []string {
+	if sm.accountExtractor != nil {
+		if addrs, handled := sm.accountExtractor.ExtractAddresses(eventType, key, value); handled {
+			return addrs
+		}
+	}
+
+	extractor, found := sm.addressExtractors[eventType+"."+key]
+	if !found {
+		if extractor, found = sm.addressExtractors[eventType]; !found {
+			extractor = GrepAddressExtractor
+		}
+	}
+
+	return extractor(value, sm.grepAddresses)
+}
+
+// grepAddresses finds the bech32 addresses of the chain and the hex addresses in the string
+func (sm Indexer) grepAddresses(str string) []string {
+	addrs := sm.bech32Regex.FindAllString(str, -1)
+	return append(addrs, findAllHexAddress(str)...)
+}
+
+// parseAddress parses the bech32 address with the address codec or the hex address
+func (sm Indexer) parseAddress(addr string) (sdk.AccAddress, error) {
+	return AccAddressFromString(sm.ac, addr)
+}
@@ -0,0 +1,116 @@
+package tx
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// applyOrderBy sets the order of the page request. pageReq.Reverse is kept if orderBy is unspecified.
+func applyOrderBy(pageReq *query.PageRequest, orderBy tx.OrderBy) *query.PageRequest {
+	if orderBy == tx.OrderBy_ORDER_BY_UNSPECIFIED {
+		return pageReq
+	}
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	pageReq.Reverse = orderBy == tx.OrderBy_ORDER_BY_DESC
+	return pageReq
+}
+
+func hasRangeFilter(fromHeight, toHeight int64, fromTime, toTime *time.Time) bool {
+	return fromHeight != 0 || toHeight != 0 || fromTime != nil || toTime != nil
+}
+
+// heightRange resolves the height and the time filters into an inclusive height range where zero means unbounded.
+// found is false if no height matches the filters.
+func (sm Indexer) heightRange(ctx context.Context, fromHeight, toHeight int64, fromTime, toTime *time.Time) (int64, int64, bool, error) {
+	if fromTime != nil {
+		height, found, err := firstValue(ctx, sm.heightByTimeMap, new(collections.Range[time.Time]).StartInclusive(*fromTime))
+		if err != nil || !found {
+			return 0, 0, false, err
+		}
+		if height > fromHeight {
+			fromHeight = height
+		}
+	}
+
+	if toTime != nil {
+		height, found, err := firstValue(ctx, sm.heightByTimeMap, new(collections.Range[time.Time]).EndInclusive(*toTime).Descending())
+		if err != nil || !found {
+			return 0, 0, false, err
+		}
+		if toHeight == 0 || height < toHeight {
+			toHeight = height
+		}
+	}
+
+	if toHeight > 0 && fromHeight > toHeight {
+		return 0, 0, false, nil
+	}
+
+	return fromHeight, toHeight, true, nil
+}
+
+// SequenceRange returns the range [start, end) of the sequences of the txs in the inclusive height range
+func (sm Indexer) SequenceRange(ctx context.Context, fromHeight, toHeight int64) (start, end uint64, err error) {
+	// sequenceByHeightMap stores the next sequence of the height
+	if fromHeight > 1 {
+		start, _, err = firstValue(ctx, sm.sequenceByHeightMap, new(collections.Range[int64]).EndExclusive(fromHeight).Descending())
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if toHeight > 0 {
+		end, _, err = firstValue(ctx, sm.sequenceByHeightMap, new(collections.Range[int64]).EndInclusive(toHeight).Descending())
+	} else {
+		end, err = sm.sequence.Peek(ctx)
+	}
+
+	return start, end, err
+}
+
+// accountSequenceRange returns the range [start, end) of the account sequences of the txs in the inclusive height range
+func (sm Indexer) accountSequenceRange(ctx context.Context, acc sdk.AccAddress, fromHeight, toHeight int64) (start, end uint64, err error) {
+	// accountHeightSequenceMap stores the next account sequence of the height
+	if fromHeight > 1 {
+		start, _, err = firstValue(ctx, sm.accountHeightSequenceMap, collections.NewPrefixedPairRange[sdk.AccAddress, int64](acc).EndExclusive(fromHeight).Descending())
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if toHeight > 0 {
+		end, _, err = firstValue(ctx, sm.accountHeightSequenceMap, collections.NewPrefixedPairRange[sdk.AccAddress, int64](acc).EndInclusive(toHeight).Descending())
+		return start, end, err
+	}
+
+	end, err = sm.accountSequenceMap.Get(ctx, acc)
+	if err != nil && cosmoserr.IsOf(err, collections.ErrNotFound) {
+		err = nil
+	}
+
+	return start, end, err
+}
+
+// firstValue returns the value of the first entry of the map in the range
+func firstValue[K, V any](ctx context.Context, m *collections.Map[K, V], rng collections.Ranger[K]) (value V, found bool, err error) {
+	iter, err := m.Iterate(ctx, rng)
+	if err != nil {
+		return value, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return value, false, nil
+	}
+
+	value, err = iter.Value()
+	return value, err == nil, err
+}
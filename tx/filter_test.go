@@ -0,0 +1,78 @@
+package tx
+
+import (
+	"testing"
+)
+
+func TestSequenceRange(t *testing.T) {
+	sub, ctx := newTestIndexer(t)
+
+	// the sequences of the txs are 0 at height 1, none at height 2, 1 and 2 at height 3 and 3 at height 5
+	finalizeTestBlock(t, sub, ctx, 1, testTx{from: testAccount(1), to: testAccount(2)})
+	finalizeTestBlock(t, sub, ctx, 2)
+	finalizeTestBlock(t, sub, ctx, 3, testTx{from: testAccount(1), to: testAccount(3)}, testTx{from: testAccount(2), to: testAccount(3)})
+	finalizeTestBlock(t, sub, ctx, 5, testTx{from: testAccount(3), to: testAccount(1)})
+
+	tests := []struct {
+		name                 string
+		fromHeight, toHeight int64
+		start, end           uint64
+	}{
+		{"unbounded", 0, 0, 0, 4},
+		{"from the first height", 1, 0, 0, 4},
+		{"single height", 3, 3, 1, 3},
+		{"empty height", 2, 2, 1, 1},
+		{"to the height without block", 0, 4, 0, 3},
+		{"from the height without block", 4, 0, 3, 4},
+		{"above the latest height", 6, 0, 4, 4},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, err := sub.SequenceRange(ctx, tc.fromHeight, tc.toHeight)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if start != tc.start || end != tc.end {
+				t.Errorf("got [%d, %d), want [%d, %d)", start, end, tc.start, tc.end)
+			}
+		})
+	}
+}
+
+func TestAccountSequenceRange(t *testing.T) {
+	sub, ctx := newTestIndexer(t)
+
+	// the next account sequence skips one after each block, so the account sequences of account 1
+	// are 0 at height 1, 2 at height 3 and 4 at height 5
+	finalizeTestBlock(t, sub, ctx, 1, testTx{from: testAccount(1), to: testAccount(2)})
+	finalizeTestBlock(t, sub, ctx, 2, testTx{from: testAccount(2), to: testAccount(3)})
+	finalizeTestBlock(t, sub, ctx, 3, testTx{from: testAccount(1), to: testAccount(3)})
+	finalizeTestBlock(t, sub, ctx, 5, testTx{from: testAccount(3), to: testAccount(1)})
+
+	tests := []struct {
+		name                 string
+		acc                  byte
+		fromHeight, toHeight int64
+		start, end           uint64
+	}{
+		{"unbounded", 1, 0, 0, 0, 6},
+		{"single height", 1, 3, 3, 2, 4},
+		{"height without tx of the account", 1, 2, 2, 2, 2},
+		{"to the height", 1, 0, 3, 0, 4},
+		{"from the height", 1, 4, 0, 4, 6},
+		{"above the latest height", 1, 6, 0, 6, 6},
+		{"other account", 2, 2, 0, 2, 4},
+		{"unknown account", 9, 0, 0, 0, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, err := sub.accountSequenceRange(ctx, testAccount(tc.acc), tc.fromHeight, tc.toHeight)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if start != tc.start || end != tc.end {
+				t.Errorf("got [%d, %d), want [%d, %d)", start, end, tc.start, tc.end)
+			}
+		})
+	}
+}
@@ -0,0 +1,521 @@
+package tx
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	txdecode "cosmossdk.io/x/tx/decode"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+
+	"github.com/initia-labs/kvindexer/collection"
+	"github.com/initia-labs/kvindexer/tx/types"
+	"github.com/initia-labs/kvindexer/util"
+)
+
+var _ types.QueryServer = (*Querier)(nil)
+
+// maxTxsByHashes is the maximum number of the hashes queried at once by TxsByHashes
+const maxTxsByHashes = 100
+
+type Querier struct {
+	Indexer
+}
+
+func NewQuerier(sb Indexer) types.QueryServer {
+	return Querier{sb}
+}
+
+// Tx implements types.QueryServer.
+func (q Querier) Tx(ctx context.Context, req *types.QueryTxRequest) (*types.QueryTxResponse, error) {
+	if req.TxHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty tx hash")
+	}
+
+	txHash := strings.ToLower(req.TxHash)
+	tx := q.getTx(ctx, txHash)
+
+	return &types.QueryTxResponse{Tx: &tx}, nil
+}
+
+// TxsByHashes implements types.QueryServer.
+func (q Querier) TxsByHashes(ctx context.Context, req *types.QueryTxsByHashesRequest) (*types.QueryTxsByHashesResponse, error) {
+	if len(req.TxHashes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx hashes")
+	}
+	if len(req.TxHashes) > maxTxsByHashes {
+		return nil, status.Errorf(codes.InvalidArgument, "too many tx hashes: %d > %d", len(req.TxHashes), maxTxsByHashes)
+	}
+
+	res := &types.QueryTxsByHashesResponse{
+		Txs:             []*sdk.TxResponse{},
+		MissingTxHashes: []string{},
+	}
+	for _, txHash := range req.TxHashes {
+		if txHash == "" {
+			return nil, status.Error(codes.InvalidArgument, "empty tx hash")
+		}
+
+		txHash = strings.ToLower(txHash)
+		tx, err := q.txMap.Get(ctx, txHash)
+		if err != nil {
+			if !cosmoserr.IsOf(err, collections.ErrNotFound) {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			res.MissingTxHashes = append(res.MissingTxHashes, txHash)
+			continue
+		}
+		res.Txs = append(res.Txs, &tx)
+	}
+
+	return res, nil
+}
+
+// TxBySignerSequence implements types.QueryServer.
+func (q Querier) TxBySignerSequence(ctx context.Context, req *types.QueryTxBySignerSequenceRequest) (*types.QueryTxResponse, error) {
+	if req.Signer == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty signer")
+	}
+
+	signer, err := AccAddressFromString(q.ac, req.Signer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txHash, err := q.txhashBySignerSequenceMap.Get(ctx, collections.Join(signer, req.Sequence))
+	if err != nil {
+		if cosmoserr.IsOf(err, collections.ErrNotFound) {
+			// the tx may be stored before the signer index
+			if err := q.checkIndexed(ctx, 0, 0, nil); err != nil {
+				return nil, err
+			}
+			return nil, status.Error(codes.NotFound, "tx not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tx := q.getTx(ctx, txHash)
+
+	return &types.QueryTxResponse{Tx: &tx}, nil
+}
+
+// AccountSummary implements types.QueryServer.
+func (q Querier) AccountSummary(ctx context.Context, req *types.QueryAccountSummaryRequest) (*types.QueryAccountSummaryResponse, error) {
+	if req.Account == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty account")
+	}
+
+	acc, err := AccAddressFromString(q.ac, req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	summary, err := q.accountSummaryMap.Get(ctx, acc)
+	if err != nil {
+		if cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "account not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	indicesHeight, _, _, err := q.indicesStart(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountSummaryResponse{Summary: &summary, IndexedFromHeight: indicesHeight}, nil
+}
+
+// TxsByAccount implements types.QueryServer.
+func (q Querier) TxsByAccount(ctx context.Context, req *types.QueryTxsByAccountRequest) (*types.QueryTxsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.Account == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty account")
+	}
+	if req.Role != types.TxRoleUnspecified {
+		if _, found := types.TxRole_name[int32(req.Role)]; !found {
+			return nil, status.Error(codes.InvalidArgument, "invalid role")
+		}
+	}
+	if err := validateStatus(req.Status); err != nil {
+		return nil, err
+	}
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative height")
+	}
+
+	acc, err := AccAddressFromString(q.ac, req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Role != types.TxRoleUnspecified || req.Status != types.TxStatusUnspecified || hasRangeFilter(req.FromHeight, req.ToHeight, req.FromTime, req.ToTime) {
+		if err := q.checkIndexed(ctx, req.FromHeight, req.ToHeight, req.FromTime); err != nil {
+			return nil, err
+		}
+	}
+
+	req.Pagination = applyOrderBy(req.Pagination, req.OrderBy)
+
+	var txHashes []*string
+	var pageRes *query.PageResponse
+	if hasRangeFilter(req.FromHeight, req.ToHeight, req.FromTime, req.ToTime) {
+		fromHeight, toHeight, found, herr := q.heightRange(ctx, req.FromHeight, req.ToHeight, req.FromTime, req.ToTime)
+		if herr != nil {
+			return nil, status.Error(codes.Internal, herr.Error())
+		}
+		if !found {
+			return &types.QueryTxsResponse{Pagination: &query.PageResponse{}}, nil
+		}
+
+		var start, end uint64
+		if req.Role == types.TxRoleUnspecified && req.Status == types.TxStatusUnspecified {
+			start, end, err = q.accountSequenceRange(ctx, acc, fromHeight, toHeight)
+		} else {
+			// the role and the status indices are keyed by the sequence of the tx
+			start, end, err = q.SequenceRange(ctx, fromHeight, toHeight)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		switch {
+		case req.Role != types.TxRoleUnspecified:
+			startKey, endKey := collections.Join3(acc, int32(req.Role), start), collections.Join3(acc, int32(req.Role), end)
+			txHashes, pageRes, err = util.CollectionRangePaginate(ctx, q.txhashesByAccountRoleMap, &startKey, &endKey, req.Pagination,
+				statusPredicate[collections.Triple[sdk.AccAddress, int32, uint64]](ctx, q.Indexer, req.Status),
+				func(_ collections.Triple[sdk.AccAddress, int32, uint64], value string) (*string, error) {
+					return &value, nil
+				},
+			)
+		case req.Status != types.TxStatusUnspecified:
+			startKey, endKey := collections.Join3(acc, int32(req.Status), start), collections.Join3(acc, int32(req.Status), end)
+			txHashes, pageRes, err = util.CollectionRangePaginate(ctx, q.txhashesByAccountStatusMap, &startKey, &endKey, req.Pagination, nil,
+				func(_ collections.Triple[sdk.AccAddress, int32, uint64], value string) (*string, error) {
+					return &value, nil
+				},
+			)
+		default:
+			startKey, endKey := collections.Join(acc, start), collections.Join(acc, end)
+			txHashes, pageRes, err = util.CollectionRangePaginate(ctx, q.txhashesByAccountMap, &startKey, &endKey, req.Pagination, nil,
+				func(_ collections.Pair[sdk.AccAddress, uint64], value string) (*string, error) {
+					return &value, nil
+				},
+			)
+		}
+	} else {
+		switch {
+		case req.Role != types.TxRoleUnspecified:
+			txHashes, pageRes, err = query.CollectionFilteredPaginate(ctx, q.txhashesByAccountRoleMap, req.Pagination,
+				statusPredicate[collections.Triple[sdk.AccAddress, int32, uint64]](ctx, q.Indexer, req.Status),
+				func(_ collections.Triple[sdk.AccAddress, int32, uint64], value string) (*string, error) {
+					return &value, nil
+				},
+				collection.WithCollectionPaginationTriplePrefix2[sdk.AccAddress, int32, uint64](acc, int32(req.Role)),
+			)
+		case req.Status != types.TxStatusUnspecified:
+			txHashes, pageRes, err = query.CollectionPaginate(ctx, q.txhashesByAccountStatusMap, req.Pagination,
+				func(_ collections.Triple[sdk.AccAddress, int32, uint64], value string) (*string, error) {
+					return &value, nil
+				},
+				collection.WithCollectionPaginationTriplePrefix2[sdk.AccAddress, int32, uint64](acc, int32(req.Status)),
+			)
+		default:
+			txHashes, pageRes, err = query.CollectionPaginate(ctx, q.txhashesByAccountMap, req.Pagination,
+				func(_ collections.Pair[sdk.AccAddress, uint64], value string) (*string, error) {
+					return &value, nil
+				},
+				query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](acc),
+			)
+		}
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	txs := q.getTxs(ctx, txHashes)
+
+	return &types.QueryTxsResponse{
+		Txs:        txs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Txs implements types.QueryServer.
+func (q Querier) Txs(ctx context.Context, req *types.QueryTxsRequest) (*types.QueryTxsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if err := validateStatus(req.Status); err != nil {
+		return nil, err
+	}
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative height")
+	}
+
+	if req.Status != types.TxStatusUnspecified || hasRangeFilter(req.FromHeight, req.ToHeight, req.FromTime, req.ToTime) {
+		if err := q.checkIndexed(ctx, req.FromHeight, req.ToHeight, req.FromTime); err != nil {
+			return nil, err
+		}
+	}
+
+	req.Pagination = applyOrderBy(req.Pagination, req.OrderBy)
+
+	if hasRangeFilter(req.FromHeight, req.ToHeight, req.FromTime, req.ToTime) {
+		return q.txsInRange(ctx, req)
+	}
+
+	if req.Status != types.TxStatusUnspecified {
+		txHashes, pageRes, err := query.CollectionPaginate(ctx, q.txhashesByStatusMap, req.Pagination,
+			func(_ collections.Pair[int32, uint64], value string) (*string, error) {
+				return &value, nil
+			},
+			query.WithCollectionPaginationPairPrefix[int32, uint64](int32(req.Status)),
+		)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		txs := q.getTxs(ctx, txHashes)
+
+		return &types.QueryTxsResponse{
+			Txs:        txs,
+			Pagination: pageRes,
+		}, nil
+	}
+
+	txHashes, pageRes, err := query.CollectionPaginate(ctx, q.txhashesBySequenceMap, req.Pagination,
+		func(_ uint64, value string) (*string, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	txs := q.getTxs(ctx, txHashes)
+	txCountRes, err := q.TxCount(ctx, &types.QueryTxCountRequest{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pageRes.Total = txCountRes.Count
+	return &types.QueryTxsResponse{
+		Txs:        txs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// txsInRange returns the txs in the height and time range of the request
+func (q Querier) txsInRange(ctx context.Context, req *types.QueryTxsRequest) (*types.QueryTxsResponse, error) {
+	fromHeight, toHeight, found, err := q.heightRange(ctx, req.FromHeight, req.ToHeight, req.FromTime, req.ToTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return &types.QueryTxsResponse{Pagination: &query.PageResponse{}}, nil
+	}
+
+	start, end, err := q.SequenceRange(ctx, fromHeight, toHeight)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var txHashes []*string
+	var pageRes *query.PageResponse
+	if req.Status != types.TxStatusUnspecified {
+		startKey, endKey := collections.Join(int32(req.Status), start), collections.Join(int32(req.Status), end)
+		txHashes, pageRes, err = util.CollectionRangePaginate(ctx, q.txhashesByStatusMap, &startKey, &endKey, req.Pagination, nil,
+			func(_ collections.Pair[int32, uint64], value string) (*string, error) {
+				return &value, nil
+			},
+		)
+	} else {
+		txHashes, pageRes, err = util.CollectionRangePaginate(ctx, q.txhashesBySequenceMap, &start, &end, req.Pagination, nil,
+			func(_ uint64, value string) (*string, error) {
+				return &value, nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	txs := q.getTxs(ctx, txHashes)
+
+	return &types.QueryTxsResponse{
+		Txs:        txs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TxsByHeight implements types.QueryServer.
+func (q Querier) TxsByHeight(ctx context.Context, req *types.QueryTxsByHeightRequest) (*types.QueryTxsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if err := validateStatus(req.Status); err != nil {
+		return nil, err
+	}
+
+	var txHashes []*string
+	var pageRes *query.PageResponse
+	var err error
+	if req.Status != types.TxStatusUnspecified {
+		if err = q.checkIndexed(ctx, req.Height, req.Height, nil); err != nil {
+			return nil, err
+		}
+		txHashes, pageRes, err = query.CollectionPaginate(ctx, q.txhashesByHeightStatusMap, req.Pagination,
+			func(_ collections.Triple[int64, int32, uint64], value string) (*string, error) {
+				return &value, nil
+			},
+			collection.WithCollectionPaginationTriplePrefix2[int64, int32, uint64](req.Height, int32(req.Status)),
+		)
+	} else {
+		txHashes, pageRes, err = query.CollectionPaginate(ctx, q.txhashesByHeightMap, req.Pagination,
+			func(_ collections.Pair[int64, uint64], value string) (*string, error) {
+				return &value, nil
+			},
+			query.WithCollectionPaginationPairPrefix[int64, uint64](req.Height),
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	txs := q.getTxs(ctx, txHashes)
+
+	return &types.QueryTxsResponse{
+		Txs:        txs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TxsByEvents implements types.QueryServer.
+func (q Querier) TxsByEvents(ctx context.Context, req *types.QueryTxsByEventsRequest) (*types.QueryTxsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty query")
+	}
+
+	eventQuery, err := cmtquery.New(req.Query)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tag, value, found := q.indexedCondition(eventQuery)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "query must have an equality condition on an indexed event attribute")
+	}
+	if err := q.checkIndexed(ctx, 0, 0, nil); err != nil {
+		return nil, err
+	}
+
+	txHashes, pageRes, err := query.CollectionFilteredPaginate(ctx, q.eventIndexMap, req.Pagination,
+		func(_ collections.Triple[string, string, uint64], txHash string) (bool, error) {
+			tx, err := q.txMap.Get(ctx, txHash)
+			if err != nil {
+				if cosmoserr.IsOf(err, collections.ErrNotFound) {
+					return false, nil
+				}
+				return false, err
+			}
+			return eventQuery.Matches(flattenEvents(&tx))
+		},
+		func(_ collections.Triple[string, string, uint64], txHash string) (*string, error) {
+			return &txHash, nil
+		},
+		collection.WithCollectionPaginationTriplePrefix2[string, string, uint64](tag, value),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	txs := q.getTxs(ctx, txHashes)
+
+	return &types.QueryTxsResponse{
+		Txs:        txs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TxsByMsgType implements types.QueryServer.
+func (q Querier) TxsByMsgType(ctx context.Context, req *types.QueryTxsByMsgTypeRequest) (*types.QueryTxsResponse, error) {
+	util.ValidatePageRequest(req.Pagination)
+	if req.MsgTypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg type url")
+	}
+	if err := q.checkIndexed(ctx, 0, 0, nil); err != nil {
+		return nil, err
+	}
+
+	var txHashes []*string
+	var pageRes *query.PageResponse
+	var err error
+	if req.Account == "" {
+		txHashes, pageRes, err = query.CollectionPaginate(ctx, q.txhashesByMsgTypeMap, req.Pagination,
+			func(_ collections.Pair[string, uint64], value string) (*string, error) {
+				return &value, nil
+			},
+			query.WithCollectionPaginationPairPrefix[string, uint64](req.MsgTypeUrl),
+		)
+	} else {
+		acc, aerr := AccAddressFromString(q.ac, req.Account)
+		if aerr != nil {
+			return nil, status.Error(codes.InvalidArgument, aerr.Error())
+		}
+
+		txHashes, pageRes, err = query.CollectionPaginate(ctx, q.txhashesByAccountMsgTypeMap, req.Pagination,
+			func(_ collections.Triple[sdk.AccAddress, string, uint64], value string) (*string, error) {
+				return &value, nil
+			},
+			collection.WithCollectionPaginationTriplePrefix2[sdk.AccAddress, string, uint64](acc, req.MsgTypeUrl),
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	txs := q.getTxs(ctx, txHashes)
+
+	return &types.QueryTxsResponse{
+		Txs:        txs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TxCount implements types.QueryServer.
+func (q Querier) TxCount(ctx context.Context, _ *types.QueryTxCountRequest) (*types.QueryTxCountResponse, error) {
+	count, err := q.sequence.Peek(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTxCountResponse{
+		Count: count + 1, // count is zerobase
+	}, nil
+}
+
+func (q Querier) getTxs(ctx context.Context, txHashes []*string) (txs []*sdk.TxResponse) {
+	for _, txHash := range txHashes {
+		tx := q.getTx(ctx, *txHash)
+		txs = append(txs, &tx)
+	}
+	return
+}
+
+func (q Querier) getTx(ctx context.Context, txHash string) sdk.TxResponse {
+	tx, err := q.txMap.Get(ctx, txHash)
+	if err == nil {
+		return tx
+	}
+	q.Logger(ctx).Info("failed to get tx", "tx_hash", txHash, "error", err)
+	e := txdecode.ErrTxDecode
+	return sdk.TxResponse{
+		TxHash:    txHash,
+		Codespace: e.Codespace(),
+		Code:      e.ABCICode(),
+		RawLog:    e.Wrap(err.Error()).Error(),
+	}
+}
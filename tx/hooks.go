@@ -0,0 +1,48 @@
+package tx
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IndexedTx is the tx passed to the IndexHook
+type IndexedTx struct {
+	// Hash is the lowercase hex hash of the tx
+	Hash       string
+	TxResponse *sdk.TxResponse
+	// Accounts are the accounts involved in the tx
+	Accounts []sdk.AccAddress
+	// Signers are the signers of the tx in order
+	Signers []sdk.AccAddress
+
+	// TxBytes and BlockIndex are set only when the tx is stored, as they are not recovered
+	// from the stored tx on removal.
+	TxBytes    []byte
+	BlockIndex uint32
+}
+
+// IndexHook stores and removes the indices of the variant of the tx submodule along with the
+// indices of the txs. The indices keyed by the sequence of the tx are pruned along with the tx.
+type IndexHook interface {
+	StoreTx(ctx context.Context, seq uint64, tx IndexedTx) error
+	// RemoveTx is called before the tx is removed on pruning
+	RemoveTx(ctx context.Context, seq uint64, tx IndexedTx) error
+}
+
+// indexedTx returns the tx passed to the IndexHook
+func (idx txIndex) indexedTx() IndexedTx {
+	signers := make([]sdk.AccAddress, 0, len(idx.signers))
+	for _, ss := range idx.signers {
+		signers = append(signers, ss.signer)
+	}
+
+	return IndexedTx{
+		Hash:       idx.hash,
+		TxResponse: idx.txr,
+		Accounts:   idx.accounts,
+		Signers:    signers,
+		TxBytes:    idx.txBytes,
+		BlockIndex: idx.blockIndex,
+	}
+}
@@ -0,0 +1,318 @@
+package tx
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/grpc"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/initia-labs/kvindexer/collection"
+	"github.com/initia-labs/kvindexer/tx/types"
+	kvindexer "github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+var _ kvindexer.Submodule = Indexer{}
+var _ kvindexer.Verifier = Indexer{}
+
+// Indexer indexes the txs and serves the queries of the tx submodule. It is shared by
+// the variants of the tx submodule, which differ only in the accounts found in the events
+// of their VM and in the indices of their own.
+type Indexer struct {
+	name    string
+	version string
+
+	ac  address.Codec
+	cdc codec.Codec
+
+	accountExtractor AccountExtractor
+	indexHooks       []IndexHook
+
+	eventAllowlist    eventAllowlist
+	bech32Regex       *regexp.Regexp
+	addressExtractors map[string]AddressExtractor
+
+	sequence              *collections.Sequence
+	txMap                 *collections.Map[string, sdk.TxResponse]
+	txhashesBySequenceMap *collections.Map[uint64, string]
+	txhashesByHeightMap   *collections.Map[collections.Pair[int64, uint64], string]
+	txhashesByAccountMap  *collections.Map[collections.Pair[sdk.AccAddress, uint64], string]
+	accountSequenceMap    *collections.Map[sdk.AccAddress, uint64]
+	eventIndexMap         *collections.Map[collections.Triple[string, string, uint64], string]
+
+	txhashesByMsgTypeMap        *collections.Map[collections.Pair[string, uint64], string]
+	txhashesByAccountMsgTypeMap *collections.Map[collections.Triple[sdk.AccAddress, string, uint64], string]
+	txhashesByAccountRoleMap    *collections.Map[collections.Triple[sdk.AccAddress, int32, uint64], string]
+	txhashBySignerSequenceMap   *collections.Map[collections.Pair[sdk.AccAddress, uint64], string]
+	txhashesByStatusMap         *collections.Map[collections.Pair[int32, uint64], string]
+	txhashesByHeightStatusMap   *collections.Map[collections.Triple[int64, int32, uint64], string]
+	txhashesByAccountStatusMap  *collections.Map[collections.Triple[sdk.AccAddress, int32, uint64], string]
+	accountSummaryMap           *collections.Map[sdk.AccAddress, types.AccountSummary]
+
+	// for pruning
+	txIndexKeysMap             *collections.Map[uint64, types.TxIndexKeys]
+	sequenceByHeightMap        *collections.Map[int64, uint64]
+	accountSequenceByHeightMap *collections.Map[collections.Triple[int64, sdk.AccAddress, uint64], bool]
+
+	// for height and time range filters
+	heightByTimeMap          *collections.Map[time.Time, int64]
+	accountHeightSequenceMap *collections.Map[collections.Pair[sdk.AccAddress, int64], uint64]
+
+	// migrationInfo stores the state of the migrations, internal use only
+	migrationInfo *collections.Map[string, string]
+	migrated      *sync.Once
+}
+
+// NewIndexer returns the indexer storing the txs under the name of the submodule.
+// accountExtractor finds the accounts in the events of the VM. It can be nil if the
+// accounts are found by the AddressExtractor options only.
+func NewIndexer(
+	name string,
+	version string,
+	cdc codec.Codec,
+	indexerKeeper collection.IndexerKeeper,
+	accountExtractor AccountExtractor,
+	opts ...Option,
+) (*Indexer, error) {
+	sequencePrefix := collection.NewPrefix(name, types.SequencePrefix)
+	sequence, err := collection.AddSequence(indexerKeeper, sequencePrefix, "sequence")
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxs := collection.NewPrefix(name, types.TxsPrefix)
+	txMap, err := collection.AddMap(indexerKeeper, prefixTxs, "txs", collections.StringKey, codec.CollValue[sdk.TxResponse](cdc))
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxsByAccount := collection.NewPrefix(name, types.TxsByAccountPrefix)
+	txhashesByAccountMap, err := collection.AddMap(indexerKeeper, prefixTxsByAccount, "txs_by_account", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxSequences := collection.NewPrefix(name, types.TxSequencePrefix)
+	txhashesBySequenceMap, err := collection.AddMap(indexerKeeper, prefixTxSequences, "tx_sequences", collections.Uint64Key, collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxIndexKeys := collection.NewPrefix(name, types.TxIndexKeysPrefix)
+	txIndexKeysMap, err := collection.AddMap(indexerKeeper, prefixTxIndexKeys, "tx_index_keys", collections.Uint64Key, codec.CollValue[types.TxIndexKeys](cdc))
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxsByHeight := collection.NewPrefix(name, types.TxByHeightPrefix)
+	txhashesByHeightMap, err := collection.AddMap(indexerKeeper, prefixTxsByHeight, "txs_by_height", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixAccountSequences := collection.NewPrefix(name, types.AccountSequencePrefix)
+	accountSequenceMap, err := collection.AddMap(indexerKeeper, prefixAccountSequences, "account_sequences", sdk.AccAddressKey, collections.Uint64Value)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixSequenceByHeight := collection.NewPrefix(name, types.SequenceByHeightPrefix)
+	sequenceByHeightMap, err := collection.AddMap(indexerKeeper, prefixSequenceByHeight, "sequence_by_height", collections.Int64Key, collections.Uint64Value)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixAccountSequenceByHeight := collection.NewPrefix(name, types.AccountSequenceByHeightPrefix)
+	accountSequenceByHeightMap, err := collection.AddMap(indexerKeeper, prefixAccountSequenceByHeight, "account_sequence_by_height", collections.TripleKeyCodec(collections.Int64Key, sdk.AccAddressKey, collections.Uint64Key), collections.BoolValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixEventIndex := collection.NewPrefix(name, types.EventIndexPrefix)
+	eventIndexMap, err := collection.AddMap(indexerKeeper, prefixEventIndex, "event_index", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxsByMsgType := collection.NewPrefix(name, types.TxsByMsgTypePrefix)
+	txhashesByMsgTypeMap, err := collection.AddMap(indexerKeeper, prefixTxsByMsgType, "txs_by_msg_type", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxsByAccountMsgType := collection.NewPrefix(name, types.TxsByAccountMsgTypePrefix)
+	txhashesByAccountMsgTypeMap, err := collection.AddMap(indexerKeeper, prefixTxsByAccountMsgType, "txs_by_account_msg_type", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxsByAccountRole := collection.NewPrefix(name, types.TxsByAccountRolePrefix)
+	txhashesByAccountRoleMap, err := collection.AddMap(indexerKeeper, prefixTxsByAccountRole, "txs_by_account_role", collections.TripleKeyCodec(sdk.AccAddressKey, collections.Int32Key, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxBySignerSequence := collection.NewPrefix(name, types.TxBySignerSequencePrefix)
+	txhashBySignerSequenceMap, err := collection.AddMap(indexerKeeper, prefixTxBySignerSequence, "tx_by_signer_sequence", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxsByStatus := collection.NewPrefix(name, types.TxsByStatusPrefix)
+	txhashesByStatusMap, err := collection.AddMap(indexerKeeper, prefixTxsByStatus, "txs_by_status", collections.PairKeyCodec(collections.Int32Key, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxsByHeightStatus := collection.NewPrefix(name, types.TxsByHeightStatusPrefix)
+	txhashesByHeightStatusMap, err := collection.AddMap(indexerKeeper, prefixTxsByHeightStatus, "txs_by_height_status", collections.TripleKeyCodec(collections.Int64Key, collections.Int32Key, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixTxsByAccountStatus := collection.NewPrefix(name, types.TxsByAccountStatusPrefix)
+	txhashesByAccountStatusMap, err := collection.AddMap(indexerKeeper, prefixTxsByAccountStatus, "txs_by_account_status", collections.TripleKeyCodec(sdk.AccAddressKey, collections.Int32Key, collections.Uint64Key), collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixHeightByTime := collection.NewPrefix(name, types.HeightByTimePrefix)
+	prefixAccountSummary := collection.NewPrefix(name, types.AccountSummaryPrefix)
+	accountSummaryMap, err := collection.AddMap(indexerKeeper, prefixAccountSummary, "account_summary", sdk.AccAddressKey, codec.CollValue[types.AccountSummary](cdc))
+	if err != nil {
+		return nil, err
+	}
+
+	heightByTimeMap, err := collection.AddMap(indexerKeeper, prefixHeightByTime, "height_by_time", sdk.TimeKey, collections.Int64Value)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixAccountHeightSequence := collection.NewPrefix(name, types.AccountHeightSequencePrefix)
+	accountHeightSequenceMap, err := collection.AddMap(indexerKeeper, prefixAccountHeightSequence, "account_height_sequence", collections.PairKeyCodec(sdk.AccAddressKey, collections.Int64Key), collections.Uint64Value)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixMigration := collection.NewPrefix(name, types.MigrationPrefix)
+	migrationMap, err := collection.AddMap(indexerKeeper, prefixMigration, "migration", collections.StringKey, collections.StringValue)
+	if err != nil {
+		return nil, err
+	}
+
+	ac := indexerKeeper.GetAddressCodec()
+	bech32Regex, err := newBech32Regex(ac)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &Indexer{
+		name:    name,
+		version: version,
+
+		ac:  ac,
+		cdc: cdc,
+
+		accountExtractor: accountExtractor,
+
+		eventAllowlist:    newEventAllowlist(DefaultEventIndexAllowlist),
+		bech32Regex:       bech32Regex,
+		addressExtractors: map[string]AddressExtractor{},
+
+		sequence:                    sequence,
+		txMap:                       txMap,
+		txhashesByAccountMap:        txhashesByAccountMap,
+		txhashesBySequenceMap:       txhashesBySequenceMap,
+		txhashesByHeightMap:         txhashesByHeightMap,
+		txIndexKeysMap:              txIndexKeysMap,
+		accountSequenceMap:          accountSequenceMap,
+		sequenceByHeightMap:         sequenceByHeightMap,
+		accountSequenceByHeightMap:  accountSequenceByHeightMap,
+		eventIndexMap:               eventIndexMap,
+		txhashesByMsgTypeMap:        txhashesByMsgTypeMap,
+		txhashesByAccountMsgTypeMap: txhashesByAccountMsgTypeMap,
+		txhashesByAccountRoleMap:    txhashesByAccountRoleMap,
+		txhashBySignerSequenceMap:   txhashBySignerSequenceMap,
+		txhashesByStatusMap:         txhashesByStatusMap,
+		txhashesByHeightStatusMap:   txhashesByHeightStatusMap,
+		txhashesByAccountStatusMap:  txhashesByAccountStatusMap,
+		accountSummaryMap:           accountSummaryMap,
+		heightByTimeMap:             heightByTimeMap,
+		accountHeightSequenceMap:    accountHeightSequenceMap,
+		migrationInfo:               migrationMap,
+		migrated:                    &sync.Once{},
+	}
+
+	for _, opt := range opts {
+		opt(sub)
+	}
+
+	return sub, nil
+}
+
+// Logger returns a module-specific logger.
+func (sub Indexer) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+sub.name)
+}
+
+func (sub Indexer) Name() string {
+	return sub.name
+}
+
+func (sub Indexer) Version() string {
+	return sub.version
+}
+
+func (sub Indexer) RegisterQueryHandlerClient(cc client.Context, mux *runtime.ServeMux) error {
+	return types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(cc))
+}
+
+func (sub Indexer) RegisterQueryServer(s grpc.Server) {
+	types.RegisterQueryServer(s, NewQuerier(sub))
+}
+
+func (sub Indexer) Prepare(ctx context.Context) error {
+	return nil
+}
+
+func (sub Indexer) Initialize(ctx context.Context) error {
+	return nil
+}
+
+func (sub Indexer) FinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	if err := sub.migrateHandler(ctx, req.Height, req.Time); err != nil {
+		sub.Logger(ctx).Error("failed to migrate", "error", err)
+		return err
+	}
+	return sub.finalizeBlock(ctx, req, res)
+}
+
+func (sub Indexer) Commit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+func (sub Indexer) Prune(ctx context.Context, minHeight int64) error {
+	return sub.prune(ctx, minHeight)
+}
+
+func (sub Indexer) Verify(ctx context.Context) ([]kvindexer.InvariantViolation, error) {
+	return sub.verify(ctx)
+}
+
+// GetTx returns the tx of the lowercase hex hash for the queries of the variants
+func (sub Indexer) GetTx(ctx context.Context, txHash string) (sdk.TxResponse, error) {
+	return sub.txMap.Get(ctx, txHash)
+}
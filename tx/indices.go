@@ -0,0 +1,261 @@
+package tx
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/initia-labs/kvindexer/tx/types"
+)
+
+// txIndex holds the data of the tx from which its indices keyed by sequence are built
+type txIndex struct {
+	hash        string
+	txr         *sdk.TxResponse
+	msgTypeURLs []string
+	accounts    []sdk.AccAddress
+	roles       []AccountRole
+	signers     []signerSequence
+	fee         sdk.Coins
+	// txBytes and blockIndex are not recovered from the stored tx
+	txBytes    []byte
+	blockIndex uint32
+}
+
+func (sm Indexer) newTxIndex(txHash string, txr *sdk.TxResponse, decoded *tx.Tx, addrs []string) txIndex {
+	idx := txIndex{
+		hash: txHash,
+		txr:  txr,
+		fee:  decoded.GetAuthInfo().GetFee().GetAmount(),
+	}
+
+	for _, msg := range decoded.GetBody().GetMessages() {
+		idx.msgTypeURLs = uniqueAppend(idx.msgTypeURLs, msg.TypeUrl)
+	}
+
+	seen := map[string]bool{}
+	for _, addr := range addrs {
+		if seen[addr] {
+			continue
+		}
+		seen[addr] = true
+
+		acc, err := sm.ac.StringToBytes(addr)
+		if err != nil {
+			continue
+		}
+		idx.accounts = append(idx.accounts, sdk.AccAddress(acc))
+	}
+
+	idx.roles = sm.grepAccountRoles(decoded, txr, idx.accounts)
+	idx.signers = grepSignerSequences(sm.ac, decoded, txr)
+
+	return idx
+}
+
+// storeTxIndices stores the indices of the tx keyed by its sequence along with their keys
+func (sm Indexer) storeTxIndices(ctx context.Context, seq uint64, idx txIndex) error {
+	events := sm.eventIndexKeys(idx.txr)
+	for _, key := range events {
+		if err := sm.eventIndexMap.Set(ctx, collections.Join3(key.Tag, key.Value, seq), idx.hash); err != nil {
+			return err
+		}
+	}
+
+	for _, typeURL := range idx.msgTypeURLs {
+		if err := sm.txhashesByMsgTypeMap.Set(ctx, collections.Join(typeURL, seq), idx.hash); err != nil {
+			return err
+		}
+
+		for _, acc := range idx.accounts {
+			if err := sm.txhashesByAccountMsgTypeMap.Set(ctx, collections.Join3(acc, typeURL, seq), idx.hash); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, ar := range idx.roles {
+		if err := sm.txhashesByAccountRoleMap.Set(ctx, collections.Join3(ar.Account, int32(ar.Role), seq), idx.hash); err != nil {
+			return err
+		}
+	}
+
+	statusKey := int32(txStatus(idx.txr.Code))
+	if err := sm.txhashesByStatusMap.Set(ctx, collections.Join(statusKey, seq), idx.hash); err != nil {
+		return err
+	}
+	if err := sm.txhashesByHeightStatusMap.Set(ctx, collections.Join3(idx.txr.Height, statusKey, seq), idx.hash); err != nil {
+		return err
+	}
+	for _, acc := range idx.accounts {
+		if err := sm.txhashesByAccountStatusMap.Set(ctx, collections.Join3(acc, statusKey, seq), idx.hash); err != nil {
+			return err
+		}
+	}
+
+	for _, ss := range idx.signers {
+		if err := sm.txhashBySignerSequenceMap.Set(ctx, collections.Join(ss.signer, ss.sequence), idx.hash); err != nil {
+			return err
+		}
+	}
+
+	if err := sm.txIndexKeysMap.Set(ctx, seq, idx.indexKeys(events)); err != nil {
+		return err
+	}
+
+	for _, hook := range sm.indexHooks {
+		if err := hook.StoreTx(ctx, seq, idx.indexedTx()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeTxIndices removes the indices of the tx keyed by its sequence by the keys stored along with them.
+// it must be called before the tx is removed.
+func (sm Indexer) removeTxIndices(ctx context.Context, seq uint64, txHash string) error {
+	txr, err := sm.txMap.Get(ctx, txHash)
+	if err != nil {
+		if cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	idx, events, err := sm.storedTxIndex(ctx, seq, txHash, &txr)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range events {
+		if err := sm.eventIndexMap.Remove(ctx, collections.Join3(key.Tag, key.Value, seq)); err != nil {
+			return err
+		}
+	}
+
+	for _, typeURL := range idx.msgTypeURLs {
+		if err := sm.txhashesByMsgTypeMap.Remove(ctx, collections.Join(typeURL, seq)); err != nil {
+			return err
+		}
+
+		for _, acc := range idx.accounts {
+			if err := sm.txhashesByAccountMsgTypeMap.Remove(ctx, collections.Join3(acc, typeURL, seq)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, ar := range idx.roles {
+		if err := sm.txhashesByAccountRoleMap.Remove(ctx, collections.Join3(ar.Account, int32(ar.Role), seq)); err != nil {
+			return err
+		}
+	}
+
+	statusKey := int32(txStatus(idx.txr.Code))
+	if err := sm.txhashesByStatusMap.Remove(ctx, collections.Join(statusKey, seq)); err != nil {
+		return err
+	}
+	if err := sm.txhashesByHeightStatusMap.Remove(ctx, collections.Join3(idx.txr.Height, statusKey, seq)); err != nil {
+		return err
+	}
+	for _, acc := range idx.accounts {
+		if err := sm.txhashesByAccountStatusMap.Remove(ctx, collections.Join3(acc, statusKey, seq)); err != nil {
+			return err
+		}
+	}
+
+	for _, ss := range idx.signers {
+		// the sequence is reused by the next tx if the tx failed before increasing it
+		key := collections.Join(ss.signer, ss.sequence)
+		stored, err := sm.txhashBySignerSequenceMap.Get(ctx, key)
+		if err != nil && !cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return err
+		}
+		if stored != txHash {
+			continue
+		}
+
+		if err := sm.txhashBySignerSequenceMap.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	if err := sm.txIndexKeysMap.Remove(ctx, seq); err != nil {
+		return err
+	}
+
+	for _, hook := range sm.indexHooks {
+		if err := hook.RemoveTx(ctx, seq, idx.indexedTx()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// storedTxIndex returns the tx index and the event index keys by the keys stored along with the indices.
+// they are rebuilt from the stored tx if the tx is stored before the keys are.
+func (sm Indexer) storedTxIndex(ctx context.Context, seq uint64, txHash string, txr *sdk.TxResponse) (txIndex, []types.EventIndexKey, error) {
+	keys, err := sm.txIndexKeysMap.Get(ctx, seq)
+	if err == nil {
+		return newStoredTxIndex(txHash, txr, keys), keys.Events, nil
+	}
+	if !cosmoserr.IsOf(err, collections.ErrNotFound) {
+		return txIndex{}, nil, err
+	}
+
+	decoded := &tx.Tx{}
+	if txr.Tx != nil {
+		if decoded, err = parseTx(sm.cdc, txr.Tx.Value); err != nil {
+			return txIndex{}, nil, err
+		}
+	}
+
+	addrs, err := sm.grepAccounts(txr, decoded)
+	if err != nil {
+		return txIndex{}, nil, err
+	}
+
+	return sm.newTxIndex(txHash, txr, decoded, addrs), sm.eventIndexKeys(txr), nil
+}
+
+// indexKeys returns the keys of the indices of the tx stored along with them
+func (idx txIndex) indexKeys(events []types.EventIndexKey) types.TxIndexKeys {
+	keys := types.TxIndexKeys{
+		Events:      events,
+		MsgTypeUrls: idx.msgTypeURLs,
+	}
+	for _, acc := range idx.accounts {
+		keys.Accounts = append(keys.Accounts, acc)
+	}
+	for _, ar := range idx.roles {
+		keys.Roles = append(keys.Roles, types.AccountRoleKey{Account: ar.Account, Role: int32(ar.Role)})
+	}
+	for _, ss := range idx.signers {
+		keys.Signers = append(keys.Signers, types.SignerSequenceKey{Signer: ss.signer, Sequence: ss.sequence})
+	}
+	return keys
+}
+
+// newStoredTxIndex returns the tx index of the keys stored along with the indices
+func newStoredTxIndex(txHash string, txr *sdk.TxResponse, keys types.TxIndexKeys) txIndex {
+	idx := txIndex{
+		hash:        txHash,
+		txr:         txr,
+		msgTypeURLs: keys.MsgTypeUrls,
+	}
+	for _, acc := range keys.Accounts {
+		idx.accounts = append(idx.accounts, sdk.AccAddress(acc))
+	}
+	for _, ar := range keys.Roles {
+		idx.roles = append(idx.roles, AccountRole{Account: sdk.AccAddress(ar.Account), Role: types.TxRole(ar.Role)})
+	}
+	for _, ss := range keys.Signers {
+		idx.signers = append(idx.signers, signerSequence{signer: sdk.AccAddress(ss.Signer), sequence: ss.Sequence})
+	}
+	return idx
+}
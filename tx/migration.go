@@ -0,0 +1,127 @@
+package tx
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	keyMigrateIndices = "migrate-indices"
+	keyIndicesHeight  = "indices-height"
+	keyIndicesTime    = "indices-time"
+
+	// indicesSchemaVersion is the version of the index layout stored as the migration marker. It is
+	// independent of the versions of the submodules sharing the indexer.
+	indicesSchemaVersion = "indices-v1"
+)
+
+// migrateHandler records where the indices added by the indices schema v1 start on the node
+// upgraded from the previous versions. It runs once on the first block after the start.
+func (sm Indexer) migrateHandler(ctx context.Context, height int64, blockTime time.Time) (err error) {
+	sm.migrated.Do(func() {
+		_, e := sm.migrationInfo.Get(ctx, keyMigrateIndices)
+		if e == nil {
+			return
+		}
+		if !cosmoserr.IsOf(e, collections.ErrNotFound) {
+			err = e
+			return
+		}
+
+		// if not found, it means migration is needed.
+		err = sm.migrateIndicesV1(ctx, height, blockTime)
+		if err != nil {
+			return
+		}
+		err = sm.migrationInfo.Set(ctx, keyMigrateIndices, indicesSchemaVersion)
+	})
+
+	return err
+}
+
+// migrateIndicesV1 stores the height and the time of the first block indexed with the event, msg type, role,
+// status, signer, height range and summary indices. The txs stored before are not in them, and they are not
+// backfilled as the txs of the pruned heights can't be recovered.
+func (sm Indexer) migrateIndicesV1(ctx context.Context, height int64, blockTime time.Time) error {
+	seq, err := sm.sequence.Peek(ctx)
+	if err != nil {
+		return err
+	}
+	// no tx is stored by the previous versions, so the indices are complete
+	if seq == 0 {
+		return nil
+	}
+
+	sm.Logger(ctx).Info("the txs below the height are not in the indices added by the indices schema", "schema", indicesSchemaVersion, "height", height)
+	if err = sm.migrationInfo.Set(ctx, keyIndicesHeight, strconv.FormatInt(height, 10)); err != nil {
+		return err
+	}
+	return sm.migrationInfo.Set(ctx, keyIndicesTime, blockTime.UTC().Format(time.RFC3339Nano))
+}
+
+// indicesStart returns the height and the time of the first block in the indices added by the indices schema v1.
+// found is false if the indices have all the txs stored.
+func (sm Indexer) indicesStart(ctx context.Context) (height int64, blockTime time.Time, found bool, err error) {
+	value, err := sm.migrationInfo.Get(ctx, keyIndicesHeight)
+	if err != nil {
+		if cosmoserr.IsOf(err, collections.ErrNotFound) {
+			err = nil
+		}
+		return 0, time.Time{}, false, err
+	}
+	if height, err = strconv.ParseInt(value, 10, 64); err != nil {
+		return 0, time.Time{}, false, err
+	}
+
+	value, err = sm.migrationInfo.Get(ctx, keyIndicesTime)
+	if err != nil {
+		return 0, time.Time{}, false, err
+	}
+	if blockTime, err = time.Parse(time.RFC3339Nano, value); err != nil {
+		return 0, time.Time{}, false, err
+	}
+
+	return height, blockTime, true, nil
+}
+
+// checkIndexed returns an error if the txs stored by the previous versions, which are not in the indices
+// added by the indices schema v1, are in the height and the time range. Zero heights and nil times mean unbounded.
+func (sm Indexer) checkIndexed(ctx context.Context, fromHeight, toHeight int64, fromTime *time.Time) error {
+	indicesHeight, indicesTime, found, err := sm.indicesStart(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !found || fromHeight >= indicesHeight {
+		return nil
+	}
+	// the times below the indices height are not indexed, so the height of the time is not resolved
+	if fromTime != nil && !fromTime.Before(indicesTime) {
+		return nil
+	}
+
+	// the txs in [fromHeight, endHeight) are not in the indices
+	endHeight := indicesHeight
+	if toHeight > 0 && toHeight < indicesHeight {
+		endHeight = toHeight + 1
+	}
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		StartInclusive(collections.Join(fromHeight, uint64(0))).
+		EndExclusive(collections.Join(endHeight, uint64(0)))
+
+	iter, err := sm.txhashesByHeightMap.Iterate(ctx, rng)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	if iter.Valid() {
+		return status.Errorf(codes.FailedPrecondition, "the txs below height %d are not indexed for the query; set from_height to %d or above", indicesHeight, indicesHeight)
+	}
+	return nil
+}
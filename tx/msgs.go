@@ -0,0 +1,147 @@
+package tx
+
+import (
+	"errors"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	// addressScalar is the cosmos_proto.scalar of the account address fields
+	addressScalar = "cosmos.AddressString"
+
+	// maxMsgDepth limits the depth of the nested messages walked, e.g. the messages of authz MsgExec
+	maxMsgDepth = 8
+)
+
+// grepAddressesFromMsgs returns the signers of the messages and the addresses in the message fields
+// annotated as cosmos.AddressString. Unlike the events, the messages are kept even if the tx failed.
+func (sm Indexer) grepAddressesFromMsgs(decoded *tx.Tx) []string {
+	grepped := []string{}
+	for _, msg := range decoded.GetBody().GetMessages() {
+		if signers, _, err := sm.cdc.GetMsgAnySigners(msg); err == nil {
+			for _, signer := range signers {
+				if addr, err := sm.ac.BytesToString(signer); err == nil {
+					grepped = append(grepped, addr)
+				}
+			}
+		}
+
+		for _, addr := range sm.msgAddresses(msg.TypeUrl, msg.Value, 0) {
+			accAddr, err := sm.parseAddress(addr)
+			if err != nil {
+				continue
+			}
+			if addr, err = sm.ac.BytesToString(accAddr); err != nil {
+				continue
+			}
+			grepped = append(grepped, addr)
+		}
+	}
+
+	return grepped
+}
+
+// grepAccounts returns the addresses involved in the tx from its events and messages
+func (sm Indexer) grepAccounts(txr *sdk.TxResponse, decoded *tx.Tx) ([]string, error) {
+	addrs, err := sm.grepAddressesFromTx(txr)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(addrs, sm.grepAddressesFromMsgs(decoded)...), nil
+}
+
+// msgAddresses returns the addresses found in the message by the account extractor of the VM
+// and the values of the address fields of the message
+func (sm Indexer) msgAddresses(typeURL string, value []byte, depth int) []string {
+	addrs := []string{}
+	if extractor, ok := sm.accountExtractor.(MsgAccountExtractor); ok {
+		addrs = append(addrs, extractor.ExtractMsgAddresses(typeURL, value)...)
+	}
+
+	msg, err := sm.decodeAny(typeURL, value)
+	if err != nil {
+		return addrs
+	}
+
+	return append(addrs, sm.addressFields(msg, depth)...)
+}
+
+// decodeAny decodes the value of the any into a dynamic message using the descriptor in the interface registry
+func (sm Indexer) decodeAny(typeURL string, value []byte) (protoreflect.Message, error) {
+	name := typeURL[strings.LastIndex(typeURL, "/")+1:]
+	desc, err := sm.cdc.InterfaceRegistry().FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errors.New("not a message: " + name)
+	}
+
+	msg := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// addressFields returns the values of the address fields of the message and its nested messages
+func (sm Indexer) addressFields(msg protoreflect.Message, depth int) []string {
+	if depth > maxMsgDepth {
+		return nil
+	}
+
+	// unpack the any to walk the message inside
+	if msg.Descriptor().FullName() == "google.protobuf.Any" {
+		fields := msg.Descriptor().Fields()
+		return sm.msgAddresses(msg.Get(fields.ByName("type_url")).String(), msg.Get(fields.ByName("value")).Bytes(), depth+1)
+	}
+
+	addrs := []string{}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				addrs = append(addrs, sm.addressValues(fd, list.Get(i), depth)...)
+			}
+		case fd.IsMap():
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				addrs = append(addrs, sm.addressValues(fd.MapValue(), mv, depth)...)
+				return true
+			})
+		default:
+			addrs = append(addrs, sm.addressValues(fd, v, depth)...)
+		}
+		return true
+	})
+
+	return addrs
+}
+
+func (sm Indexer) addressValues(fd protoreflect.FieldDescriptor, v protoreflect.Value, depth int) []string {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		if isAddressField(fd) && v.String() != "" {
+			return []string{v.String()}
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return sm.addressFields(v.Message(), depth+1)
+	}
+	return nil
+}
+
+func isAddressField(fd protoreflect.FieldDescriptor) bool {
+	scalar, ok := proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar).(string)
+	return ok && scalar == addressScalar
+}
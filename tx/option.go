@@ -0,0 +1,42 @@
+package tx
+
+// Option configures the Indexer
+type Option func(*Indexer)
+
+// DefaultEventIndexAllowlist is the default set of the event attributes indexed for TxsByEvents
+var DefaultEventIndexAllowlist = []string{
+	"message.action",
+	"message.sender",
+	"message.module",
+	"transfer.sender",
+	"transfer.recipient",
+}
+
+// WithEventIndexAllowlist sets the event attributes indexed for TxsByEvents.
+// An entry is either an event type (e.g. "transfer") to index all the attributes of the event,
+// or an event type and an attribute key joined by a dot (e.g. "transfer.recipient").
+func WithEventIndexAllowlist(allowlist ...string) Option {
+	return func(sub *Indexer) {
+		sub.eventAllowlist = newEventAllowlist(allowlist)
+	}
+}
+
+// WithAddressExtractor sets the extractor finding the account addresses in the attributes of the event.
+// If key is empty, the extractor is used for all the attributes of the event type unless another
+// extractor is set to the attribute.
+func WithAddressExtractor(eventType, key string, extractor AddressExtractor) Option {
+	return func(sub *Indexer) {
+		if key == "" {
+			sub.addressExtractors[eventType] = extractor
+			return
+		}
+		sub.addressExtractors[eventType+"."+key] = extractor
+	}
+}
+
+// WithIndexHook adds the hook storing and removing the indices of the variant along with the txs
+func WithIndexHook(hook IndexHook) Option {
+	return func(sub *Indexer) {
+		sub.indexHooks = append(sub.indexHooks, hook)
+	}
+}
@@ -2,12 +2,13 @@ package tx
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func (sub TxSubmodule) prune(ctx context.Context, minHeight int64) error {
+func (sub Indexer) prune(ctx context.Context, minHeight int64) error {
 	// clear txhashesBySequenceMap using sequenceByHeightMap
 	var sequence uint64
 	rnHeight := new(collections.Range[int64]).StartInclusive(1).EndInclusive(minHeight)
@@ -26,16 +27,36 @@ func (sub TxSubmodule) prune(ctx context.Context, minHeight int64) error {
 		return err
 	}
 
-	rnSeq := new(collections.Range[uint64]).EndInclusive(sequence)
+	// sequenceByHeightMap stores the next sequence of the height, so the sequence itself is retained
+	rnSeq := new(collections.Range[uint64]).EndExclusive(sequence)
+
+	// clear the indices keyed by sequence before the txs are removed
+	prunedTxs := map[uint64]string{}
+	err = sub.txhashesBySequenceMap.Walk(ctx, rnSeq, func(seq uint64, txHash string) (bool, error) {
+		prunedTxs[seq] = txHash
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for seq, txHash := range prunedTxs {
+		if err = sub.removeTxIndices(ctx, seq, txHash); err != nil {
+			return err
+		}
+	}
+
 	if err = sub.txhashesBySequenceMap.Clear(ctx, rnSeq); err != nil {
 		return err
 	}
 
 	// clear txhashesByAccountMap using accountSequenceByHeightMap
 	accountSequenceMap := make(map[string]uint64)
+	var accountHeights []collections.Pair[sdk.AccAddress, int64]
 	rnTriple := collections.NewPrefixUntilTripleRange[int64, sdk.AccAddress, uint64](minHeight)
 	err = sub.accountSequenceByHeightMap.Walk(ctx, rnTriple, func(key collections.Triple[int64, sdk.AccAddress, uint64], value bool) (bool, error) {
-		accountSequenceMap[key.K2().String()] = key.K3()
+		accountSequenceMap[string(key.K2())] = key.K3()
+		accountHeights = append(accountHeights, collections.Join(key.K2(), key.K1()))
 		return false, nil
 	})
 	if err != nil {
@@ -46,13 +67,15 @@ func (sub TxSubmodule) prune(ctx context.Context, minHeight int64) error {
 		return err
 	}
 
-	for addr, seq := range accountSequenceMap {
-		acc, err := sdk.AccAddressFromBech32(addr)
-		if err != nil {
+	for _, key := range accountHeights {
+		if err = sub.accountHeightSequenceMap.Remove(ctx, key); err != nil {
 			return err
 		}
+	}
 
-		rnPair := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](acc).EndInclusive(seq)
+	// accountSequenceByHeightMap stores the next account sequence of the height as well
+	for addr, seq := range accountSequenceMap {
+		rnPair := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](sdk.AccAddress(addr)).EndExclusive(seq)
 		if err = sub.txhashesByAccountMap.Clear(ctx, rnPair); err != nil {
 			return err
 		}
@@ -80,5 +103,24 @@ func (sub TxSubmodule) prune(ctx context.Context, minHeight int64) error {
 		}
 	}
 
+	// clear heightByTimeMap, whose heights increase along with the times
+	var blockTimes []time.Time
+	err = sub.heightByTimeMap.Walk(ctx, nil, func(blockTime time.Time, height int64) (bool, error) {
+		if height > minHeight {
+			return true, nil
+		}
+		blockTimes = append(blockTimes, blockTime)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, blockTime := range blockTimes {
+		if err := sub.heightByTimeMap.Remove(ctx, blockTime); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,77 @@
+package tx
+
+import (
+	"testing"
+)
+
+func TestStorePruneRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		// reconfigure changes the indexer between storing and pruning
+		reconfigure func(sub *Indexer)
+	}{
+		{"same configuration", func(sub *Indexer) {}},
+		{"event allowlist emptied", func(sub *Indexer) { sub.eventAllowlist = newEventAllowlist(nil) }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sub, ctx := newTestIndexer(t)
+
+			finalizeTestBlock(t, sub, ctx, 1, testTx{from: testAccount(1), to: testAccount(2)})
+			finalizeTestBlock(t, sub, ctx, 2, testTx{from: testAccount(2), to: testAccount(3), failed: true})
+			finalizeTestBlock(t, sub, ctx, 3, testTx{from: testAccount(3), to: testAccount(1)})
+
+			if n := count(t, ctx, sub.txMap); n != 3 {
+				t.Fatalf("stored %d txs, want 3", n)
+			}
+			if n := count(t, ctx, sub.eventIndexMap); n == 0 {
+				t.Fatal("no event index stored")
+			}
+			if n := count(t, ctx, sub.txIndexKeysMap); n != 3 {
+				t.Fatalf("stored the index keys of %d txs, want 3", n)
+			}
+
+			tc.reconfigure(sub)
+			if err := sub.Prune(ctx, 2); err != nil {
+				t.Fatal(err)
+			}
+
+			// only the tx at height 3 remains in every map
+			remaining := []struct {
+				name string
+				got  int
+				want int
+			}{
+				{"txs", count(t, ctx, sub.txMap), 1},
+				{"txs by sequence", count(t, ctx, sub.txhashesBySequenceMap), 1},
+				{"txs by height", count(t, ctx, sub.txhashesByHeightMap), 1},
+				{"txs by account", count(t, ctx, sub.txhashesByAccountMap), 2},
+				{"event index", count(t, ctx, sub.eventIndexMap), 2},
+				{"txs by msg type", count(t, ctx, sub.txhashesByMsgTypeMap), 1},
+				{"txs by account msg type", count(t, ctx, sub.txhashesByAccountMsgTypeMap), 2},
+				{"txs by account role", count(t, ctx, sub.txhashesByAccountRoleMap), 2},
+				{"txs by status", count(t, ctx, sub.txhashesByStatusMap), 1},
+				{"txs by height status", count(t, ctx, sub.txhashesByHeightStatusMap), 1},
+				{"txs by account status", count(t, ctx, sub.txhashesByAccountStatusMap), 2},
+				{"tx index keys", count(t, ctx, sub.txIndexKeysMap), 1},
+				{"sequence by height", count(t, ctx, sub.sequenceByHeightMap), 1},
+				{"account sequence by height", count(t, ctx, sub.accountSequenceByHeightMap), 2},
+				{"account height sequence", count(t, ctx, sub.accountHeightSequenceMap), 2},
+				{"height by time", count(t, ctx, sub.heightByTimeMap), 1},
+			}
+			for _, r := range remaining {
+				if r.got != r.want {
+					t.Errorf("%s: %d entries remain, want %d", r.name, r.got, r.want)
+				}
+			}
+
+			violations, err := sub.Verify(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(violations) != 0 {
+				t.Errorf("invariant violations after pruning: %v", violations)
+			}
+		})
+	}
+}
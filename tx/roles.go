@@ -0,0 +1,96 @@
+package tx
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/initia-labs/kvindexer/tx/types"
+)
+
+var (
+	senderAttributeKeys    = map[string]bool{"sender": true, "spender": true, "from": true}
+	recipientAttributeKeys = map[string]bool{"recipient": true, "receiver": true, "to": true}
+)
+
+// AccountRole is the role of an account in the tx
+type AccountRole struct {
+	Account sdk.AccAddress
+	Role    types.TxRole
+}
+
+// grepAccountRoles returns the roles of the accounts involved in the tx.
+// the accounts having no other role are recorded as mentioned.
+func (sm Indexer) grepAccountRoles(decoded *tx.Tx, txr *sdk.TxResponse, accounts []sdk.AccAddress) []AccountRole {
+	roles := []AccountRole{}
+	found := map[accountRoleKey]bool{}
+	hasRole := map[string]bool{}
+	add := func(acc sdk.AccAddress, role types.TxRole) {
+		key := accountRoleKey{string(acc), role}
+		if found[key] {
+			return
+		}
+		found[key] = true
+		hasRole[string(acc)] = true
+		roles = append(roles, AccountRole{acc, role})
+	}
+
+	signers := grepSignerSequences(sm.ac, decoded, txr)
+
+	if payer := decoded.GetAuthInfo().GetFee().GetPayer(); payer != "" {
+		if acc, err := AccAddressFromString(sm.ac, payer); err == nil {
+			add(acc, types.TxRoleFeePayer)
+		}
+	} else if len(signers) > 0 {
+		// the first signer pays the fee by default
+		add(signers[0].signer, types.TxRoleFeePayer)
+	}
+
+	for _, signer := range signers {
+		add(signer.signer, types.TxRoleSigner)
+	}
+
+	for _, event := range txr.Events {
+		for _, attr := range event.Attributes {
+			if sm.accountExtractor != nil {
+				if vmRoles, handled := sm.accountExtractor.ExtractRoles(event.Type, attr.Key, attr.Value); handled {
+					for _, ar := range vmRoles {
+						add(ar.Account, ar.Role)
+					}
+					continue
+				}
+			}
+
+			var role types.TxRole
+
+			switch {
+			case event.Type == sdk.EventTypeTx && attr.Key == sdk.AttributeKeyFeePayer:
+				role = types.TxRoleFeePayer
+			case senderAttributeKeys[attr.Key]:
+				role = types.TxRoleSender
+			case recipientAttributeKeys[attr.Key]:
+				role = types.TxRoleRecipient
+			default:
+				continue
+			}
+
+			acc, err := AccAddressFromString(sm.ac, attr.Value)
+			if err != nil {
+				continue
+			}
+			add(acc, role)
+		}
+	}
+
+	for _, acc := range accounts {
+		if !hasRole[string(acc)] {
+			add(acc, types.TxRoleMentioned)
+		}
+	}
+
+	return roles
+}
+
+type accountRoleKey struct {
+	addr string
+	role types.TxRole
+}
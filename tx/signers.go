@@ -0,0 +1,67 @@
+package tx
+
+import (
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/core/address"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// signerSequence is a signer of the tx with its account sequence
+type signerSequence struct {
+	signer   sdk.AccAddress
+	sequence uint64
+}
+
+// grepSignerSequences returns the signers of the tx with their sequences from the signer infos.
+// the public key of the signer info is omitted if it is already set to the account,
+// so the acc_seq attributes of the tx events are used as well.
+func grepSignerSequences(ac address.Codec, decoded *tx.Tx, txr *sdk.TxResponse) []signerSequence {
+	signers := []signerSequence{}
+	found := map[string]bool{}
+	add := func(signer sdk.AccAddress, sequence uint64) {
+		key := string(signer) + "/" + strconv.FormatUint(sequence, 10)
+		if found[key] {
+			return
+		}
+		found[key] = true
+		signers = append(signers, signerSequence{signer, sequence})
+	}
+
+	for _, info := range decoded.GetAuthInfo().GetSignerInfos() {
+		if pk, ok := info.GetPublicKey().GetCachedValue().(cryptotypes.PubKey); ok {
+			add(sdk.AccAddress(pk.Address()), info.GetSequence())
+		}
+	}
+
+	for _, event := range txr.Events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != sdk.AttributeKeyAccountSequence {
+				continue
+			}
+
+			// acc_seq is formatted as "{address}/{sequence}"
+			addr, seqStr, ok := strings.Cut(attr.Value, "/")
+			if !ok {
+				continue
+			}
+			acc, err := AccAddressFromString(ac, addr)
+			if err != nil {
+				continue
+			}
+			sequence, err := strconv.ParseUint(seqStr, 10, 64)
+			if err != nil {
+				continue
+			}
+			add(acc, sequence)
+		}
+	}
+
+	return signers
+}
@@ -0,0 +1,45 @@
+package tx
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/initia-labs/kvindexer/tx/types"
+)
+
+// txStatus returns the status of the tx of the result code
+func txStatus(code uint32) types.TxStatus {
+	if code == 0 {
+		return types.TxStatusSuccess
+	}
+	return types.TxStatusFailed
+}
+
+func validateStatus(s types.TxStatus) error {
+	if _, found := types.TxStatus_name[int32(s)]; !found {
+		return status.Error(codes.InvalidArgument, "invalid status")
+	}
+	return nil
+}
+
+// statusPredicate returns the predicate filtering the txs by the status, or nil if the status is unspecified
+func statusPredicate[K any](ctx context.Context, sm Indexer, s types.TxStatus) func(K, string) (bool, error) {
+	if s == types.TxStatusUnspecified {
+		return nil
+	}
+
+	return func(_ K, txHash string) (bool, error) {
+		tx, err := sm.txMap.Get(ctx, txHash)
+		if err != nil {
+			if cosmoserr.IsOf(err, collections.ErrNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		return txStatus(tx.Code) == s, nil
+	}
+}
@@ -0,0 +1,64 @@
+package tx
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/tx/types"
+)
+
+// updateAccountSummary adds the txs of the block to the activity summary of the account.
+// the summary is not pruned, so it covers all the txs indexed so far.
+func (sm Indexer) updateAccountSummary(ctx context.Context, acc sdk.AccAddress, height int64, blockTime time.Time, txHashes []string, txsByHash map[string]txIndex) error {
+	summary, err := sm.accountSummaryMap.Get(ctx, acc)
+	if err != nil {
+		if !cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return err
+		}
+		account, err := sm.ac.BytesToString(acc)
+		if err != nil {
+			return err
+		}
+		summary = types.AccountSummary{
+			Account:         account,
+			FirstSeenHeight: height,
+			FirstSeenTime:   blockTime.UTC(),
+		}
+	}
+
+	summary.TxCount += uint64(len(txHashes))
+	summary.LastSeenHeight = height
+	summary.LastSeenTime = blockTime.UTC()
+
+	for _, txHash := range txHashes {
+		txIdx, found := txsByHash[txHash]
+		if !found {
+			continue
+		}
+
+		if txStatus(txIdx.txr.Code) == types.TxStatusFailed {
+			summary.FailedTxCount++
+		}
+
+		// the fee is charged even if the tx failed
+		if txIdx.hasRole(acc, types.TxRoleFeePayer) {
+			summary.FeesPaid = summary.FeesPaid.Add(txIdx.fee...)
+		}
+	}
+
+	return sm.accountSummaryMap.Set(ctx, acc, summary)
+}
+
+// hasRole returns whether the account has the role in the tx
+func (idx txIndex) hasRole(acc sdk.AccAddress, role types.TxRole) bool {
+	for _, ar := range idx.roles {
+		if ar.Role == role && ar.Account.Equals(acc) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/dbadapter"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/initia-labs/kvindexer/store"
+)
+
+// testKeeper is the collection.IndexerKeeper of the indexer under test
+type testKeeper struct {
+	sb *collections.SchemaBuilder
+	ac address.Codec
+}
+
+func (k testKeeper) IsSealed() bool                               { return false }
+func (k testKeeper) GetSchemaBuilder() *collections.SchemaBuilder { return k.sb }
+func (k testKeeper) GetAddressCodec() address.Codec               { return k.ac }
+
+// testTx is a bank send tx of the test blocks
+type testTx struct {
+	from, to sdk.AccAddress
+	failed   bool
+}
+
+func newTestIndexer(t *testing.T, opts ...Option) (*Indexer, sdk.Context) {
+	t.Helper()
+
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	kvStore := store.NewCacheStore(dbadapter.Store{DB: dbm.NewMemDB()}, 1<<20)
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) corestore.KVStore { return kvStore })
+	k := testKeeper{sb: sb, ac: addresscodec.NewBech32Codec("init")}
+
+	indexer, err := NewIndexer("tx", "v0.0.0", cdc, k, nil, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = sb.Build(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := sdk.Context{}.WithContext(context.Background()).WithLogger(log.NewNopLogger())
+	return indexer, ctx
+}
+
+// testAccount returns the account of the index
+func testAccount(i byte) sdk.AccAddress {
+	return sdk.AccAddress(append(make([]byte, 19), i))
+}
+
+// finalizeTestBlock indexes the block of the txs at the height whose time is the height in seconds
+func finalizeTestBlock(t *testing.T, sub *Indexer, ctx sdk.Context, height int64, txs ...testTx) {
+	t.Helper()
+
+	req := abci.RequestFinalizeBlock{Height: height, Time: time.Unix(height, 0).UTC()}
+	res := abci.ResponseFinalizeBlock{}
+	for _, ttx := range txs {
+		from, _ := sub.ac.BytesToString(ttx.from)
+		to, _ := sub.ac.BytesToString(ttx.to)
+
+		msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{FromAddress: from, ToAddress: to, Amount: sdk.NewCoins(sdk.NewInt64Coin("uinit", 1))})
+		if err != nil {
+			t.Fatal(err)
+		}
+		txBytes, err := sub.cdc.Marshal(&tx.Tx{
+			Body:     &tx.TxBody{Messages: []*codectypes.Any{msg}, Memo: fmt.Sprintf("%d/%d", height, len(req.Txs))},
+			AuthInfo: &tx.AuthInfo{Fee: &tx.Fee{}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result := &abci.ExecTxResult{
+			Events: []abci.Event{{
+				Type: "transfer",
+				Attributes: []abci.EventAttribute{
+					{Key: "sender", Value: from},
+					{Key: "recipient", Value: to},
+				},
+			}},
+		}
+		if ttx.failed {
+			result.Code = 1
+		}
+
+		req.Txs = append(req.Txs, txBytes)
+		res.TxResults = append(res.TxResults, result)
+	}
+
+	if err := sub.FinalizeBlock(ctx, req, res); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// count returns the number of the entries of the map
+func count[K, V any](t *testing.T, ctx context.Context, m *collections.Map[K, V]) int {
+	t.Helper()
+
+	n := 0
+	err := m.Walk(ctx, nil, func(K, V) (bool, error) {
+		n++
+		return false, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
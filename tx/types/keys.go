@@ -0,0 +1,28 @@
+package types
+
+// store prefixes shared by the variants of the tx submodule.
+// the variants must not use them for their own indices.
+// the indices are spaced by 0x10, and the indices derived from another one take the slots next to it.
+const (
+	TxsByAccountPrefix            = 0x10
+	TxsByAccountStatusPrefix      = 0x11
+	AccountSequencePrefix         = 0x20
+	AccountSummaryPrefix          = 0x21
+	EventIndexPrefix              = 0x30
+	TxsByMsgTypePrefix            = 0x40
+	TxsByAccountMsgTypePrefix     = 0x50
+	TxsByAccountRolePrefix        = 0x60
+	HeightByTimePrefix            = 0x70
+	AccountHeightSequencePrefix   = 0x80
+	TxBySignerSequencePrefix      = 0x90
+	SequencePrefix                = 0xa0
+	TxSequencePrefix              = 0xb0
+	TxIndexKeysPrefix             = 0xb1
+	TxsByStatusPrefix             = 0xb2
+	TxByHeightPrefix              = 0xc0
+	TxsByHeightStatusPrefix       = 0xc1
+	TxsPrefix                     = 0xf0
+	SequenceByHeightPrefix        = 0xd0
+	AccountSequenceByHeightPrefix = 0xe0
+	MigrationPrefix               = 0xff
+)
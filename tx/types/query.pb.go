@@ -0,0 +1,4868 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: indexer/tx/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TxRole defines how an account relates to a transaction
+type TxRole int32
+
+const (
+	TxRoleUnspecified TxRole = 0
+	// TX_ROLE_SIGNER is the account signed the tx
+	TxRoleSigner TxRole = 1
+	// TX_ROLE_FEE_PAYER is the account paid the fee of the tx
+	TxRoleFeePayer TxRole = 2
+	// TX_ROLE_SENDER is the account found in a sender attribute of the events
+	TxRoleSender TxRole = 3
+	// TX_ROLE_RECIPIENT is the account found in a recipient attribute of the
+	// events
+	TxRoleRecipient TxRole = 4
+	// TX_ROLE_MENTIONED is the account found elsewhere in the events
+	TxRoleMentioned TxRole = 5
+)
+
+var TxRole_name = map[int32]string{
+	0: "TX_ROLE_UNSPECIFIED",
+	1: "TX_ROLE_SIGNER",
+	2: "TX_ROLE_FEE_PAYER",
+	3: "TX_ROLE_SENDER",
+	4: "TX_ROLE_RECIPIENT",
+	5: "TX_ROLE_MENTIONED",
+}
+
+var TxRole_value = map[string]int32{
+	"TX_ROLE_UNSPECIFIED": 0,
+	"TX_ROLE_SIGNER":      1,
+	"TX_ROLE_FEE_PAYER":   2,
+	"TX_ROLE_SENDER":      3,
+	"TX_ROLE_RECIPIENT":   4,
+	"TX_ROLE_MENTIONED":   5,
+}
+
+func (x TxRole) String() string {
+	return proto.EnumName(TxRole_name, int32(x))
+}
+
+func (TxRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{0}
+}
+
+// TxStatus defines the result status of a transaction
+type TxStatus int32
+
+const (
+	TxStatusUnspecified TxStatus = 0
+	// TX_STATUS_SUCCESS is the transaction executed with the code 0
+	TxStatusSuccess TxStatus = 1
+	// TX_STATUS_FAILED is the transaction executed with a non-zero code
+	TxStatusFailed TxStatus = 2
+)
+
+var TxStatus_name = map[int32]string{
+	0: "TX_STATUS_UNSPECIFIED",
+	1: "TX_STATUS_SUCCESS",
+	2: "TX_STATUS_FAILED",
+}
+
+var TxStatus_value = map[string]int32{
+	"TX_STATUS_UNSPECIFIED": 0,
+	"TX_STATUS_SUCCESS":     1,
+	"TX_STATUS_FAILED":      2,
+}
+
+func (x TxStatus) String() string {
+	return proto.EnumName(TxStatus_name, int32(x))
+}
+
+func (TxStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{1}
+}
+
+// QueryTxRequest is the request type for the Query/Txs RPC method
+type QueryTxRequest struct {
+	// tx_hash is a hash string of the transaction to query.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QueryTxRequest) Reset()         { *m = QueryTxRequest{} }
+func (m *QueryTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxRequest) ProtoMessage()    {}
+func (*QueryTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{0}
+}
+func (m *QueryTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxRequest.Merge(m, src)
+}
+func (m *QueryTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxRequest proto.InternalMessageInfo
+
+func (m *QueryTxRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryTxResponse is the response type for the Query/Txs RPC method
+type QueryTxResponse struct {
+	// txs is the list of queried transactions.
+	Tx *types.TxResponse `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *QueryTxResponse) Reset()         { *m = QueryTxResponse{} }
+func (m *QueryTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxResponse) ProtoMessage()    {}
+func (*QueryTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{1}
+}
+func (m *QueryTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxResponse.Merge(m, src)
+}
+func (m *QueryTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxResponse proto.InternalMessageInfo
+
+func (m *QueryTxResponse) GetTx() *types.TxResponse {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// QueryTxsByHashesRequest is the request type for the Query/TxsByHashes RPC
+// method
+type QueryTxsByHashesRequest struct {
+	// tx_hashes are the hashes of the transactions to query. Up to 100 hashes
+	// are allowed.
+	TxHashes []string `protobuf:"bytes,1,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+}
+
+func (m *QueryTxsByHashesRequest) Reset()         { *m = QueryTxsByHashesRequest{} }
+func (m *QueryTxsByHashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxsByHashesRequest) ProtoMessage()    {}
+func (*QueryTxsByHashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{2}
+}
+func (m *QueryTxsByHashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxsByHashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxsByHashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxsByHashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxsByHashesRequest.Merge(m, src)
+}
+func (m *QueryTxsByHashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxsByHashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxsByHashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxsByHashesRequest proto.InternalMessageInfo
+
+func (m *QueryTxsByHashesRequest) GetTxHashes() []string {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+// QueryTxsByHashesResponse is the response type for the Query/TxsByHashes RPC
+// method
+type QueryTxsByHashesResponse struct {
+	// txs are the found transactions in the order of the request.
+	Txs []*types.TxResponse `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// missing_tx_hashes are the requested hashes not found in the indexer.
+	MissingTxHashes []string `protobuf:"bytes,2,rep,name=missing_tx_hashes,json=missingTxHashes,proto3" json:"missing_tx_hashes,omitempty"`
+}
+
+func (m *QueryTxsByHashesResponse) Reset()         { *m = QueryTxsByHashesResponse{} }
+func (m *QueryTxsByHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxsByHashesResponse) ProtoMessage()    {}
+func (*QueryTxsByHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{3}
+}
+func (m *QueryTxsByHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxsByHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxsByHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxsByHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxsByHashesResponse.Merge(m, src)
+}
+func (m *QueryTxsByHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxsByHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxsByHashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxsByHashesResponse proto.InternalMessageInfo
+
+func (m *QueryTxsByHashesResponse) GetTxs() []*types.TxResponse {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *QueryTxsByHashesResponse) GetMissingTxHashes() []string {
+	if m != nil {
+		return m.MissingTxHashes
+	}
+	return nil
+}
+
+// QueryTxBySignerSequenceRequest is the request type for the
+// Query/TxBySignerSequence RPC method
+type QueryTxBySignerSequenceRequest struct {
+	// signer is the address of the signer of the transaction.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// sequence is the account sequence of the signer used for the transaction.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryTxBySignerSequenceRequest) Reset()         { *m = QueryTxBySignerSequenceRequest{} }
+func (m *QueryTxBySignerSequenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxBySignerSequenceRequest) ProtoMessage()    {}
+func (*QueryTxBySignerSequenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{4}
+}
+func (m *QueryTxBySignerSequenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxBySignerSequenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxBySignerSequenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxBySignerSequenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxBySignerSequenceRequest.Merge(m, src)
+}
+func (m *QueryTxBySignerSequenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxBySignerSequenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxBySignerSequenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxBySignerSequenceRequest proto.InternalMessageInfo
+
+func (m *QueryTxBySignerSequenceRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *QueryTxBySignerSequenceRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryTxsequest is the request type for the Query/Txs RPC method
+type QueryTxsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// from_height and to_height are the optional inclusive range of the heights
+	// of the txs.
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// from_time and to_time are the optional inclusive range of the block times
+	// of the txs.
+	FromTime *time.Time `protobuf:"bytes,4,opt,name=from_time,json=fromTime,proto3,stdtime" json:"from_time,omitempty"`
+	ToTime   *time.Time `protobuf:"bytes,5,opt,name=to_time,json=toTime,proto3,stdtime" json:"to_time,omitempty"`
+	// order_by is the order of the txs. It overrides pagination.reverse if set.
+	OrderBy tx.OrderBy `protobuf:"varint,6,opt,name=order_by,json=orderBy,proto3,enum=cosmos.tx.v1beta1.OrderBy" json:"order_by,omitempty"`
+	// status is the optional result status of the txs to filter with.
+	Status TxStatus `protobuf:"varint,7,opt,name=status,proto3,enum=indexer.tx.v1.TxStatus" json:"status,omitempty"`
+}
+
+func (m *QueryTxsRequest) Reset()         { *m = QueryTxsRequest{} }
+func (m *QueryTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxsRequest) ProtoMessage()    {}
+func (*QueryTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{5}
+}
+func (m *QueryTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxsRequest.Merge(m, src)
+}
+func (m *QueryTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxsRequest proto.InternalMessageInfo
+
+func (m *QueryTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryTxsRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryTxsRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryTxsRequest) GetFromTime() *time.Time {
+	if m != nil {
+		return m.FromTime
+	}
+	return nil
+}
+
+func (m *QueryTxsRequest) GetToTime() *time.Time {
+	if m != nil {
+		return m.ToTime
+	}
+	return nil
+}
+
+func (m *QueryTxsRequest) GetOrderBy() tx.OrderBy {
+	if m != nil {
+		return m.OrderBy
+	}
+	return tx.OrderBy_ORDER_BY_UNSPECIFIED
+}
+
+func (m *QueryTxsRequest) GetStatus() TxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TxStatusUnspecified
+}
+
+// QueryTxsByAccountRequest is the request type for the Query/Txs RPC method
+type QueryTxsByAccountRequest struct {
+	// account is the account address to query txs for.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// role is the optional role of the account in the txs to filter with.
+	Role       TxRole             `protobuf:"varint,2,opt,name=role,proto3,enum=indexer.tx.v1.TxRole" json:"role,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// from_height and to_height are the optional inclusive range of the heights
+	// of the txs.
+	FromHeight int64 `protobuf:"varint,4,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,5,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// from_time and to_time are the optional inclusive range of the block times
+	// of the txs.
+	FromTime *time.Time `protobuf:"bytes,6,opt,name=from_time,json=fromTime,proto3,stdtime" json:"from_time,omitempty"`
+	ToTime   *time.Time `protobuf:"bytes,7,opt,name=to_time,json=toTime,proto3,stdtime" json:"to_time,omitempty"`
+	// order_by is the order of the txs. It overrides pagination.reverse if set.
+	OrderBy tx.OrderBy `protobuf:"varint,8,opt,name=order_by,json=orderBy,proto3,enum=cosmos.tx.v1beta1.OrderBy" json:"order_by,omitempty"`
+	// status is the optional result status of the txs to filter with.
+	Status TxStatus `protobuf:"varint,9,opt,name=status,proto3,enum=indexer.tx.v1.TxStatus" json:"status,omitempty"`
+}
+
+func (m *QueryTxsByAccountRequest) Reset()         { *m = QueryTxsByAccountRequest{} }
+func (m *QueryTxsByAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxsByAccountRequest) ProtoMessage()    {}
+func (*QueryTxsByAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{6}
+}
+func (m *QueryTxsByAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxsByAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxsByAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxsByAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxsByAccountRequest.Merge(m, src)
+}
+func (m *QueryTxsByAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxsByAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxsByAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxsByAccountRequest proto.InternalMessageInfo
+
+func (m *QueryTxsByAccountRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryTxsByAccountRequest) GetRole() TxRole {
+	if m != nil {
+		return m.Role
+	}
+	return TxRoleUnspecified
+}
+
+func (m *QueryTxsByAccountRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryTxsByAccountRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryTxsByAccountRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryTxsByAccountRequest) GetFromTime() *time.Time {
+	if m != nil {
+		return m.FromTime
+	}
+	return nil
+}
+
+func (m *QueryTxsByAccountRequest) GetToTime() *time.Time {
+	if m != nil {
+		return m.ToTime
+	}
+	return nil
+}
+
+func (m *QueryTxsByAccountRequest) GetOrderBy() tx.OrderBy {
+	if m != nil {
+		return m.OrderBy
+	}
+	return tx.OrderBy_ORDER_BY_UNSPECIFIED
+}
+
+func (m *QueryTxsByAccountRequest) GetStatus() TxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TxStatusUnspecified
+}
+
+// QueryAccountSummaryRequest is the request type for the Query/AccountSummary
+// RPC method
+type QueryAccountSummaryRequest struct {
+	// account is the account address to query the summary for.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryAccountSummaryRequest) Reset()         { *m = QueryAccountSummaryRequest{} }
+func (m *QueryAccountSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountSummaryRequest) ProtoMessage()    {}
+func (*QueryAccountSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{7}
+}
+func (m *QueryAccountSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountSummaryRequest.Merge(m, src)
+}
+func (m *QueryAccountSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryAccountSummaryRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// QueryAccountSummaryResponse is the response type for the
+// Query/AccountSummary RPC method
+type QueryAccountSummaryResponse struct {
+	Summary *AccountSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	// indexed_from_height is set on the node upgraded from the versions without
+	// the summary. The summary doesn't count the txs below the height.
+	IndexedFromHeight int64 `protobuf:"varint,2,opt,name=indexed_from_height,json=indexedFromHeight,proto3" json:"indexed_from_height,omitempty"`
+}
+
+func (m *QueryAccountSummaryResponse) Reset()         { *m = QueryAccountSummaryResponse{} }
+func (m *QueryAccountSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountSummaryResponse) ProtoMessage()    {}
+func (*QueryAccountSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{8}
+}
+func (m *QueryAccountSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountSummaryResponse.Merge(m, src)
+}
+func (m *QueryAccountSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryAccountSummaryResponse) GetSummary() *AccountSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *QueryAccountSummaryResponse) GetIndexedFromHeight() int64 {
+	if m != nil {
+		return m.IndexedFromHeight
+	}
+	return 0
+}
+
+// AccountSummary defines the activity summary of an account. It covers all the
+// indexed txs including the pruned ones.
+type AccountSummary struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// tx_count is the number of the txs involving the account
+	TxCount uint64 `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// failed_tx_count is the number of the failed txs involving the account
+	FailedTxCount   uint64    `protobuf:"varint,3,opt,name=failed_tx_count,json=failedTxCount,proto3" json:"failed_tx_count,omitempty"`
+	FirstSeenHeight int64     `protobuf:"varint,4,opt,name=first_seen_height,json=firstSeenHeight,proto3" json:"first_seen_height,omitempty"`
+	FirstSeenTime   time.Time `protobuf:"bytes,5,opt,name=first_seen_time,json=firstSeenTime,proto3,stdtime" json:"first_seen_time"`
+	LastSeenHeight  int64     `protobuf:"varint,6,opt,name=last_seen_height,json=lastSeenHeight,proto3" json:"last_seen_height,omitempty"`
+	LastSeenTime    time.Time `protobuf:"bytes,7,opt,name=last_seen_time,json=lastSeenTime,proto3,stdtime" json:"last_seen_time"`
+	// fees_paid is the total fees paid by the account as the fee payer
+	FeesPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=fees_paid,json=feesPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_paid"`
+}
+
+func (m *AccountSummary) Reset()         { *m = AccountSummary{} }
+func (m *AccountSummary) String() string { return proto.CompactTextString(m) }
+func (*AccountSummary) ProtoMessage()    {}
+func (*AccountSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{9}
+}
+func (m *AccountSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountSummary.Merge(m, src)
+}
+func (m *AccountSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountSummary proto.InternalMessageInfo
+
+func (m *AccountSummary) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountSummary) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *AccountSummary) GetFailedTxCount() uint64 {
+	if m != nil {
+		return m.FailedTxCount
+	}
+	return 0
+}
+
+func (m *AccountSummary) GetFirstSeenHeight() int64 {
+	if m != nil {
+		return m.FirstSeenHeight
+	}
+	return 0
+}
+
+func (m *AccountSummary) GetFirstSeenTime() time.Time {
+	if m != nil {
+		return m.FirstSeenTime
+	}
+	return time.Time{}
+}
+
+func (m *AccountSummary) GetLastSeenHeight() int64 {
+	if m != nil {
+		return m.LastSeenHeight
+	}
+	return 0
+}
+
+func (m *AccountSummary) GetLastSeenTime() time.Time {
+	if m != nil {
+		return m.LastSeenTime
+	}
+	return time.Time{}
+}
+
+func (m *AccountSummary) GetFeesPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesPaid
+	}
+	return nil
+}
+
+// QueryTxsByHeightRequest is the request type for the Query/Txs RPC method
+type QueryTxsByHeightRequest struct {
+	// height is the height to query txs for.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// status is the optional result status of the txs to filter with.
+	Status     TxStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=indexer.tx.v1.TxStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTxsByHeightRequest) Reset()         { *m = QueryTxsByHeightRequest{} }
+func (m *QueryTxsByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxsByHeightRequest) ProtoMessage()    {}
+func (*QueryTxsByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{10}
+}
+func (m *QueryTxsByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxsByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxsByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxsByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxsByHeightRequest.Merge(m, src)
+}
+func (m *QueryTxsByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxsByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxsByHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxsByHeightRequest proto.InternalMessageInfo
+
+func (m *QueryTxsByHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryTxsByHeightRequest) GetStatus() TxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TxStatusUnspecified
+}
+
+func (m *QueryTxsByHeightRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTxsByEventsRequest is the request type for the Query/TxsByEvents RPC
+// method
+type QueryTxsByEventsRequest struct {
+	// query is the event query in the CometBFT query language, e.g.
+	// "transfer.recipient='init1...' AND message.action='/cosmos.bank.v1beta1.MsgSend'".
+	// It must have at least one equality condition on an indexed event attribute.
+	Query      string             `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTxsByEventsRequest) Reset()         { *m = QueryTxsByEventsRequest{} }
+func (m *QueryTxsByEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxsByEventsRequest) ProtoMessage()    {}
+func (*QueryTxsByEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{11}
+}
+func (m *QueryTxsByEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxsByEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxsByEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxsByEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxsByEventsRequest.Merge(m, src)
+}
+func (m *QueryTxsByEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxsByEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxsByEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxsByEventsRequest proto.InternalMessageInfo
+
+func (m *QueryTxsByEventsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QueryTxsByEventsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTxsByMsgTypeRequest is the request type for the Query/TxsByMsgType RPC
+// method
+type QueryTxsByMsgTypeRequest struct {
+	// msg_type_url is the type url of the message, e.g.
+	// "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// account is the optional account address to filter the txs with.
+	Account    string             `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTxsByMsgTypeRequest) Reset()         { *m = QueryTxsByMsgTypeRequest{} }
+func (m *QueryTxsByMsgTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxsByMsgTypeRequest) ProtoMessage()    {}
+func (*QueryTxsByMsgTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{12}
+}
+func (m *QueryTxsByMsgTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxsByMsgTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxsByMsgTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxsByMsgTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxsByMsgTypeRequest.Merge(m, src)
+}
+func (m *QueryTxsByMsgTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxsByMsgTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxsByMsgTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxsByMsgTypeRequest proto.InternalMessageInfo
+
+func (m *QueryTxsByMsgTypeRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryTxsByMsgTypeRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryTxsByMsgTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTxCountRequest is the request type for the Query/Txs RPC method
+type QueryTxCountRequest struct {
+}
+
+func (m *QueryTxCountRequest) Reset()         { *m = QueryTxCountRequest{} }
+func (m *QueryTxCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxCountRequest) ProtoMessage()    {}
+func (*QueryTxCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{13}
+}
+func (m *QueryTxCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxCountRequest.Merge(m, src)
+}
+func (m *QueryTxCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxCountRequest proto.InternalMessageInfo
+
+// QueryTxsResponse is the response type for the Query/Txs RPC method
+type QueryTxsResponse struct {
+	// txs is the list of queried transactions.
+	Txs        []*types.TxResponse `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTxsResponse) Reset()         { *m = QueryTxsResponse{} }
+func (m *QueryTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxsResponse) ProtoMessage()    {}
+func (*QueryTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{14}
+}
+func (m *QueryTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxsResponse.Merge(m, src)
+}
+func (m *QueryTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxsResponse proto.InternalMessageInfo
+
+func (m *QueryTxsResponse) GetTxs() []*types.TxResponse {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *QueryTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryTxCountResponse struct {
+	// count is the total number of transactions.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryTxCountResponse) Reset()         { *m = QueryTxCountResponse{} }
+func (m *QueryTxCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxCountResponse) ProtoMessage()    {}
+func (*QueryTxCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c91c96051207f94b, []int{15}
+}
+func (m *QueryTxCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxCountResponse.Merge(m, src)
+}
+func (m *QueryTxCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxCountResponse proto.InternalMessageInfo
+
+func (m *QueryTxCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("indexer.tx.v1.TxRole", TxRole_name, TxRole_value)
+	proto.RegisterEnum("indexer.tx.v1.TxStatus", TxStatus_name, TxStatus_value)
+	proto.RegisterType((*QueryTxRequest)(nil), "indexer.tx.v1.QueryTxRequest")
+	proto.RegisterType((*QueryTxResponse)(nil), "indexer.tx.v1.QueryTxResponse")
+	proto.RegisterType((*QueryTxsByHashesRequest)(nil), "indexer.tx.v1.QueryTxsByHashesRequest")
+	proto.RegisterType((*QueryTxsByHashesResponse)(nil), "indexer.tx.v1.QueryTxsByHashesResponse")
+	proto.RegisterType((*QueryTxBySignerSequenceRequest)(nil), "indexer.tx.v1.QueryTxBySignerSequenceRequest")
+	proto.RegisterType((*QueryTxsRequest)(nil), "indexer.tx.v1.QueryTxsRequest")
+	proto.RegisterType((*QueryTxsByAccountRequest)(nil), "indexer.tx.v1.QueryTxsByAccountRequest")
+	proto.RegisterType((*QueryAccountSummaryRequest)(nil), "indexer.tx.v1.QueryAccountSummaryRequest")
+	proto.RegisterType((*QueryAccountSummaryResponse)(nil), "indexer.tx.v1.QueryAccountSummaryResponse")
+	proto.RegisterType((*AccountSummary)(nil), "indexer.tx.v1.AccountSummary")
+	proto.RegisterType((*QueryTxsByHeightRequest)(nil), "indexer.tx.v1.QueryTxsByHeightRequest")
+	proto.RegisterType((*QueryTxsByEventsRequest)(nil), "indexer.tx.v1.QueryTxsByEventsRequest")
+	proto.RegisterType((*QueryTxsByMsgTypeRequest)(nil), "indexer.tx.v1.QueryTxsByMsgTypeRequest")
+	proto.RegisterType((*QueryTxCountRequest)(nil), "indexer.tx.v1.QueryTxCountRequest")
+	proto.RegisterType((*QueryTxsResponse)(nil), "indexer.tx.v1.QueryTxsResponse")
+	proto.RegisterType((*QueryTxCountResponse)(nil), "indexer.tx.v1.QueryTxCountResponse")
+}
+
+func init() { proto.RegisterFile("indexer/tx/v1/query.proto", fileDescriptor_c91c96051207f94b) }
+
+var fileDescriptor_c91c96051207f94b = []byte{
+	// 1614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x4f, 0x1b, 0xc7,
+	0x16, 0x67, 0x6d, 0x63, 0x9b, 0x81, 0x18, 0x33, 0xc0, 0xc5, 0x6c, 0x12, 0xe3, 0xeb, 0xe4, 0xf2,
+	0x75, 0x2f, 0xde, 0xc0, 0xbd, 0x37, 0xf7, 0x43, 0xea, 0x03, 0x98, 0x25, 0xa1, 0x4a, 0x08, 0x5d,
+	0x1b, 0x29, 0xed, 0xcb, 0x6a, 0x6d, 0x0f, 0x66, 0x15, 0x7b, 0xd7, 0xd9, 0x19, 0xd3, 0xb5, 0x28,
+	0x55, 0x55, 0xa9, 0x5f, 0x48, 0x95, 0x22, 0xe5, 0x2d, 0x12, 0x4f, 0x7d, 0xa9, 0xf2, 0xdc, 0x87,
+	0xfe, 0x09, 0x79, 0x8c, 0xda, 0x97, 0x3e, 0x35, 0x6d, 0xd2, 0x7f, 0xa2, 0x6f, 0xd5, 0xce, 0x87,
+	0xbd, 0x6b, 0x0c, 0xa6, 0x81, 0x27, 0x7b, 0xe7, 0xfc, 0xce, 0xf9, 0xfd, 0x66, 0xce, 0x99, 0x99,
+	0x33, 0x60, 0xda, 0xb4, 0x2a, 0xc8, 0x45, 0x8e, 0x42, 0x5c, 0x65, 0x7f, 0x59, 0x79, 0xdc, 0x44,
+	0x4e, 0x2b, 0xd7, 0x70, 0x6c, 0x62, 0xc3, 0x2b, 0xdc, 0x94, 0x23, 0x6e, 0x6e, 0x7f, 0x59, 0xbe,
+	0x51, 0xb6, 0x71, 0xdd, 0xc6, 0x4a, 0xc9, 0xc0, 0x48, 0x31, 0x4a, 0x65, 0x53, 0xd9, 0x5f, 0x2e,
+	0x21, 0x62, 0x2c, 0xd3, 0x0f, 0xe6, 0x23, 0x2f, 0xfa, 0x41, 0x34, 0x58, 0x1b, 0xd5, 0x30, 0xaa,
+	0xa6, 0x65, 0x10, 0xd3, 0xb6, 0x38, 0x36, 0xed, 0xc7, 0x0a, 0x54, 0xd9, 0x36, 0x85, 0x7d, 0x86,
+	0xdb, 0x89, 0xdb, 0xb6, 0x62, 0xe4, 0xec, 0x9b, 0x65, 0xc4, 0x01, 0xd3, 0x0c, 0xa0, 0xd3, 0x2f,
+	0x85, 0x7d, 0x70, 0xd3, 0x44, 0xd5, 0xae, 0xda, 0x6c, 0xdc, 0xfb, 0xc7, 0x47, 0xaf, 0x55, 0x6d,
+	0xbb, 0x5a, 0x43, 0x8a, 0xd1, 0x30, 0x15, 0xc3, 0xb2, 0x6c, 0x42, 0xe5, 0x08, 0x9f, 0x19, 0x6e,
+	0xa5, 0x5f, 0xa5, 0xe6, 0xae, 0x42, 0xcc, 0x3a, 0xc2, 0xc4, 0xa8, 0x37, 0x18, 0x20, 0xbb, 0x00,
+	0x12, 0xef, 0x79, 0x53, 0x2a, 0xba, 0x1a, 0x7a, 0xdc, 0x44, 0x98, 0xc0, 0x29, 0x10, 0x23, 0xae,
+	0xbe, 0x67, 0xe0, 0xbd, 0x94, 0x94, 0x91, 0xe6, 0x87, 0xb4, 0x28, 0x71, 0xef, 0x1a, 0x78, 0x2f,
+	0x7b, 0x07, 0x8c, 0xb6, 0xa1, 0xb8, 0x61, 0x5b, 0x18, 0xc1, 0x7f, 0x81, 0x10, 0x71, 0x29, 0x6c,
+	0x78, 0xe5, 0x66, 0x8e, 0xab, 0xf5, 0xe6, 0x9e, 0xa3, 0xeb, 0xc7, 0xa7, 0x98, 0xeb, 0x78, 0x68,
+	0x21, 0xe2, 0x66, 0x6f, 0x83, 0x29, 0x1e, 0x08, 0xaf, 0xb5, 0xbc, 0xd0, 0x08, 0x0b, 0xf2, 0xab,
+	0x60, 0x88, 0x93, 0x23, 0x9c, 0x92, 0x32, 0xe1, 0xf9, 0x21, 0x2d, 0xce, 0xe8, 0x11, 0xce, 0x7e,
+	0x0c, 0x52, 0x27, 0xfd, 0xb8, 0x92, 0xdb, 0x20, 0x4c, 0x5c, 0xe6, 0x72, 0x5e, 0x29, 0x9e, 0x03,
+	0x5c, 0x04, 0x63, 0x75, 0x13, 0x63, 0xd3, 0xaa, 0xea, 0x1d, 0xe2, 0x10, 0x25, 0x1e, 0xe5, 0x86,
+	0xa2, 0xe0, 0xb7, 0x40, 0x9a, 0xf3, 0xaf, 0xb5, 0x0a, 0x66, 0xd5, 0x42, 0x4e, 0xc1, 0x93, 0x6d,
+	0x95, 0x91, 0x90, 0x7f, 0x0b, 0x44, 0x31, 0x35, 0xb0, 0xa5, 0x5b, 0x4b, 0xfd, 0xf0, 0xdd, 0xd2,
+	0x04, 0xd7, 0xb2, 0x5a, 0xa9, 0x38, 0x08, 0xe3, 0x02, 0x71, 0x4c, 0xab, 0xaa, 0x71, 0x1c, 0x94,
+	0x41, 0x1c, 0xf3, 0x20, 0xa9, 0x50, 0x46, 0x9a, 0x8f, 0x68, 0xed, 0xef, 0xec, 0xef, 0xa1, 0xf6,
+	0x8a, 0xb7, 0x17, 0x68, 0x03, 0x80, 0x4e, 0xd1, 0xf1, 0x95, 0x9f, 0x0d, 0x4c, 0x97, 0x95, 0xbb,
+	0x98, 0xef, 0xb6, 0x51, 0x15, 0xea, 0x34, 0x9f, 0x27, 0x9c, 0x01, 0xc3, 0xbb, 0x8e, 0x5d, 0xd7,
+	0xf7, 0x90, 0x59, 0xdd, 0x23, 0x94, 0x3a, 0xac, 0x01, 0x6f, 0xe8, 0x2e, 0x1d, 0xa1, 0x99, 0xb0,
+	0x85, 0x39, 0x4c, 0xcd, 0x71, 0x62, 0x73, 0xe3, 0x3b, 0x60, 0x88, 0x7a, 0x7b, 0xd5, 0x94, 0x8a,
+	0x50, 0x11, 0x72, 0x8e, 0x95, 0x5a, 0x4e, 0x94, 0x5a, 0xae, 0x28, 0x4a, 0x6d, 0x2d, 0xf2, 0xe4,
+	0xd5, 0x8c, 0xa4, 0xc5, 0x3d, 0x17, 0x6f, 0x10, 0xfe, 0x0f, 0xc4, 0x88, 0xcd, 0x9c, 0x07, 0xcf,
+	0xe9, 0x1c, 0x25, 0x36, 0x75, 0xfd, 0x37, 0x88, 0xdb, 0x4e, 0x05, 0x39, 0x7a, 0xa9, 0x95, 0x8a,
+	0x66, 0xa4, 0xf9, 0xc4, 0x8a, 0x2c, 0x66, 0x4f, 0xdc, 0xf6, 0xac, 0x1f, 0x78, 0x90, 0xb5, 0x96,
+	0x16, 0xb3, 0xd9, 0x1f, 0xa8, 0x80, 0x28, 0x26, 0x06, 0x69, 0xe2, 0x54, 0x8c, 0x3a, 0x4d, 0xe5,
+	0x02, 0x07, 0x41, 0xae, 0xe8, 0x16, 0xa8, 0x59, 0xe3, 0xb0, 0xec, 0xaf, 0x61, 0x7f, 0xb1, 0xad,
+	0x96, 0xcb, 0x76, 0xd3, 0x22, 0x22, 0x09, 0x2b, 0x20, 0x66, 0xb0, 0x91, 0xbe, 0x79, 0x16, 0x40,
+	0xb8, 0x00, 0x22, 0x8e, 0x5d, 0x63, 0x49, 0x4e, 0xac, 0x4c, 0x9e, 0xe0, 0xd7, 0xec, 0x1a, 0xd2,
+	0x28, 0xa4, 0x2b, 0xc7, 0xe1, 0xcb, 0xca, 0x71, 0xe4, 0xec, 0x1c, 0x0f, 0x9e, 0x95, 0xe3, 0xe8,
+	0x45, 0x72, 0x1c, 0xbb, 0x40, 0x8e, 0xe3, 0x6f, 0x93, 0xe3, 0xa1, 0xf3, 0xe5, 0x78, 0x1b, 0xc8,
+	0x34, 0xc5, 0x3c, 0xbb, 0x85, 0x66, 0xbd, 0x6e, 0x38, 0xad, 0x0b, 0x24, 0x39, 0xfb, 0xb9, 0x04,
+	0xae, 0xf6, 0x0c, 0xc9, 0x4f, 0xa9, 0xff, 0x80, 0x18, 0x66, 0x43, 0x7c, 0xeb, 0x5e, 0xef, 0xd2,
+	0xd8, 0xe5, 0x27, 0xd0, 0x30, 0x07, 0xc6, 0x19, 0xb0, 0xa2, 0x9f, 0xdc, 0xb6, 0x63, 0xdc, 0xb4,
+	0xd1, 0xce, 0xac, 0x57, 0xbe, 0x89, 0x60, 0xac, 0xb7, 0x2a, 0xda, 0x69, 0x10, 0x27, 0xae, 0xce,
+	0x9c, 0xd8, 0xe9, 0x14, 0x23, 0x6e, 0x9e, 0x9a, 0x66, 0xc1, 0xe8, 0xae, 0x61, 0xd6, 0x50, 0x45,
+	0x6f, 0x23, 0xc2, 0x14, 0x71, 0x85, 0x0d, 0x17, 0x39, 0x6e, 0x11, 0x8c, 0xed, 0x9a, 0x0e, 0x26,
+	0x3a, 0x46, 0xc8, 0x0a, 0x96, 0xe2, 0x28, 0x35, 0x14, 0x10, 0xb2, 0x78, 0xc9, 0xdd, 0x03, 0xa3,
+	0x3e, 0xec, 0x39, 0xcf, 0x87, 0xf8, 0x8b, 0x9f, 0x67, 0x06, 0x68, 0xfd, 0x5c, 0x69, 0xc7, 0xa3,
+	0x65, 0x34, 0x0f, 0x92, 0x35, 0xa3, 0x8b, 0x38, 0x4a, 0x89, 0x13, 0x35, 0x83, 0xe1, 0x38, 0xef,
+	0xbb, 0x20, 0x51, 0x33, 0x02, 0xb4, 0xb1, 0x3f, 0x41, 0x3b, 0x22, 0xa2, 0x51, 0xd6, 0x3d, 0x30,
+	0xb4, 0x8b, 0x10, 0xd6, 0x1b, 0x86, 0x59, 0x49, 0xc5, 0xe9, 0x75, 0x34, 0x1d, 0xd8, 0xbb, 0xa2,
+	0x7e, 0xf3, 0xb6, 0x69, 0xad, 0xdd, 0xf2, 0xa2, 0x3c, 0x7f, 0x35, 0x33, 0x5f, 0x35, 0xc9, 0x5e,
+	0xb3, 0x94, 0x2b, 0xdb, 0x75, 0x7e, 0xe9, 0xf3, 0x9f, 0x25, 0x5c, 0x79, 0xa4, 0x90, 0x56, 0x03,
+	0x61, 0xea, 0x80, 0xb5, 0xb8, 0x17, 0x7d, 0xdb, 0x30, 0x2b, 0xd9, 0xe7, 0x52, 0xe0, 0x1e, 0xa5,
+	0x53, 0x11, 0xc5, 0xfb, 0x17, 0x10, 0xe5, 0x33, 0x96, 0xe8, 0x8c, 0xf9, 0x97, 0x6f, 0x8f, 0x84,
+	0xce, 0xb5, 0x47, 0x2e, 0xeb, 0x2c, 0xca, 0x7e, 0xe8, 0xd7, 0xaa, 0xee, 0x23, 0x8b, 0xb4, 0xaf,
+	0xb4, 0x09, 0x30, 0x48, 0x63, 0xf0, 0x76, 0x83, 0x7d, 0x5c, 0x1a, 0xf1, 0xf7, 0x92, 0xff, 0x20,
+	0xbf, 0x8f, 0xab, 0xc5, 0x56, 0xa3, 0x7d, 0x5f, 0x67, 0xc0, 0x48, 0x1d, 0x57, 0x75, 0x6f, 0x7d,
+	0xf5, 0xa6, 0x53, 0xe3, 0x0a, 0x40, 0x9d, 0xa1, 0x76, 0x9c, 0x9a, 0x7f, 0xd7, 0x84, 0xce, 0xbb,
+	0x6b, 0x2e, 0x4b, 0xfa, 0x24, 0x18, 0xe7, 0xca, 0xf3, 0xbe, 0xdb, 0x27, 0xfb, 0x54, 0x02, 0xc9,
+	0x4e, 0x5b, 0x70, 0xc1, 0xfe, 0xe7, 0x4e, 0x40, 0x6b, 0x88, 0x6a, 0x9d, 0xeb, 0xab, 0x95, 0x47,
+	0xf0, 0x8b, 0xfd, 0x07, 0x98, 0x08, 0x8a, 0xe5, 0xc2, 0x26, 0xc0, 0x60, 0xe7, 0xd0, 0x89, 0x68,
+	0xec, 0x63, 0xf1, 0xeb, 0x10, 0x88, 0xb2, 0x3b, 0xcf, 0x3b, 0xda, 0x8a, 0x0f, 0x75, 0xed, 0xc1,
+	0x3d, 0x55, 0xdf, 0xd9, 0x2a, 0x6c, 0xab, 0xf9, 0xcd, 0x8d, 0x4d, 0x75, 0x3d, 0x39, 0x20, 0x4f,
+	0x1e, 0x1d, 0x67, 0xc6, 0x18, 0x68, 0xc7, 0xc2, 0x0d, 0x54, 0x36, 0x77, 0x4d, 0x54, 0x81, 0x37,
+	0x41, 0x42, 0xe0, 0x0b, 0x9b, 0x77, 0xb6, 0x54, 0x2d, 0x29, 0xc9, 0xc9, 0xa3, 0xe3, 0xcc, 0x08,
+	0x83, 0xb2, 0xc6, 0x0c, 0x2e, 0x80, 0x31, 0x81, 0xda, 0x50, 0x55, 0x7d, 0x7b, 0xf5, 0x7d, 0x55,
+	0x4b, 0x86, 0x64, 0x78, 0x74, 0x9c, 0x49, 0x30, 0xe0, 0x06, 0x42, 0xdb, 0x46, 0x0b, 0x39, 0x81,
+	0x80, 0xea, 0xd6, 0xba, 0xaa, 0x25, 0xc3, 0x81, 0x80, 0xc8, 0xaa, 0x20, 0xc7, 0x3b, 0xc7, 0x04,
+	0x4a, 0x53, 0xf3, 0x9b, 0xdb, 0x9b, 0xea, 0x56, 0x31, 0x19, 0x91, 0xc7, 0x8f, 0x8e, 0x33, 0xa3,
+	0x0c, 0xa8, 0xa1, 0xb2, 0xd9, 0x30, 0x91, 0x45, 0xfc, 0xd8, 0xfb, 0xea, 0x56, 0x71, 0xf3, 0xc1,
+	0x96, 0xba, 0x9e, 0x1c, 0xf4, 0x63, 0xef, 0x23, 0xcb, 0x5b, 0x35, 0x54, 0x91, 0x23, 0x5f, 0x7e,
+	0x93, 0x1e, 0x58, 0x7c, 0x26, 0x81, 0xb8, 0xd8, 0x7b, 0x70, 0x05, 0x4c, 0x16, 0x1f, 0xea, 0x85,
+	0xe2, 0x6a, 0x71, 0xa7, 0xd0, 0xb5, 0x26, 0x53, 0x47, 0xc7, 0x99, 0x71, 0x01, 0xf4, 0xaf, 0x0a,
+	0xa3, 0xe4, 0x3e, 0x85, 0x9d, 0x7c, 0x5e, 0x2d, 0x14, 0x92, 0x92, 0xa0, 0x64, 0xf8, 0x42, 0xb3,
+	0x5c, 0x46, 0x18, 0x7b, 0x07, 0x63, 0x07, 0xbb, 0xb1, 0xba, 0x79, 0x4f, 0x5d, 0xef, 0x2c, 0x0d,
+	0x83, 0x6e, 0xd0, 0x33, 0x9c, 0x89, 0x5b, 0xf9, 0x16, 0x80, 0x41, 0x9a, 0x5b, 0x48, 0x40, 0x4c,
+	0x9c, 0xeb, 0xd9, 0xae, 0x93, 0xa3, 0x47, 0xa5, 0xca, 0x37, 0xce, 0xc4, 0xb0, 0x02, 0xc9, 0x66,
+	0x3e, 0xfd, 0xf1, 0xb7, 0xa7, 0x21, 0x19, 0xa6, 0x94, 0xe0, 0xb3, 0x8d, 0xb8, 0xde, 0x19, 0xe8,
+	0x51, 0x99, 0x20, 0x54, 0x74, 0xe1, 0xf5, 0xde, 0xc1, 0x04, 0x57, 0xfa, 0x34, 0x33, 0xa7, 0xb9,
+	0x49, 0x69, 0xd2, 0xf0, 0x5a, 0x0f, 0x9a, 0x03, 0xde, 0xfa, 0x1f, 0xc2, 0x2f, 0x24, 0x30, 0xec,
+	0x7b, 0x5e, 0xc0, 0xd9, 0xde, 0x51, 0xbb, 0xdf, 0x2d, 0xf2, 0x5c, 0x5f, 0x1c, 0x97, 0x31, 0x47,
+	0x65, 0xfc, 0xf5, 0xff, 0xd2, 0x62, 0xb6, 0x97, 0x92, 0x52, 0x8b, 0xbf, 0x41, 0xe0, 0xb1, 0x04,
+	0xe0, 0xc9, 0x87, 0x06, 0x5c, 0xea, 0x4d, 0x74, 0xca, 0x83, 0xa4, 0xef, 0xaa, 0xfc, 0x97, 0xca,
+	0x59, 0x81, 0xb7, 0x7a, 0x6b, 0x61, 0x8f, 0x14, 0xe5, 0x80, 0xfd, 0x1e, 0x2a, 0x07, 0xe2, 0x6d,
+	0x72, 0x08, 0x4b, 0x20, 0x5c, 0x74, 0x31, 0x3c, 0x85, 0xa0, 0xbd, 0x30, 0x33, 0xa7, 0xda, 0xb9,
+	0x02, 0x99, 0x2a, 0x98, 0x80, 0xf0, 0xa4, 0x02, 0xf8, 0x95, 0x04, 0x46, 0xfc, 0xfd, 0x37, 0x3c,
+	0x7d, 0x99, 0x83, 0x1d, 0x7a, 0x7f, 0x5a, 0x85, 0xd2, 0x2e, 0xc0, 0xb9, 0xde, 0x13, 0xe7, 0x47,
+	0xb9, 0x72, 0xc0, 0xff, 0x1c, 0xc2, 0x67, 0xd2, 0x89, 0x8e, 0x6a, 0xa1, 0x17, 0x49, 0xcf, 0x66,
+	0x52, 0x5e, 0x3c, 0x0f, 0x94, 0x4b, 0x5b, 0xa6, 0xd2, 0xfe, 0x0e, 0x17, 0xba, 0xa4, 0x71, 0x29,
+	0xb8, 0x23, 0x4a, 0x11, 0xed, 0xe1, 0x67, 0xed, 0xb2, 0x65, 0xd7, 0xfc, 0x19, 0x65, 0xeb, 0x6f,
+	0x13, 0xfa, 0x2f, 0xd3, 0x12, 0xd5, 0x32, 0x07, 0xff, 0x76, 0x4a, 0xad, 0xd2, 0x68, 0xca, 0x01,
+	0xfb, 0x3d, 0x84, 0x1f, 0x81, 0x61, 0xdf, 0x05, 0x7f, 0x86, 0x8c, 0x40, 0x07, 0xd0, 0x5f, 0xc6,
+	0x59, 0x9b, 0xb7, 0xd4, 0xd2, 0x11, 0xa3, 0xfb, 0x44, 0x94, 0x0b, 0xbf, 0xe5, 0xcf, 0x28, 0x97,
+	0x60, 0x1f, 0xd0, 0x5f, 0xc0, 0x2c, 0x15, 0x90, 0x81, 0xe9, 0xde, 0x02, 0x44, 0x13, 0xb1, 0x96,
+	0x7f, 0xf1, 0x3a, 0x2d, 0xbd, 0x7c, 0x9d, 0x96, 0x7e, 0x79, 0x9d, 0x96, 0x9e, 0xbc, 0x49, 0x0f,
+	0xbc, 0x7c, 0x93, 0x1e, 0xf8, 0xe9, 0x4d, 0x7a, 0xe0, 0x83, 0x05, 0x5f, 0x87, 0x67, 0x5a, 0x26,
+	0x31, 0x8d, 0xa5, 0x9a, 0x51, 0xc2, 0xca, 0xa3, 0x7d, 0x5f, 0x44, 0x2f, 0x06, 0x2e, 0x45, 0x69,
+	0xbb, 0xf9, 0xcf, 0x3f, 0x06, 0x00, 0x3a, 0xdb, 0x64, 0x51, 0xc8, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TxsCount queries the total number of transactions
+	TxCount(ctx context.Context, in *QueryTxCountRequest, opts ...grpc.CallOption) (*QueryTxCountResponse, error)
+	// Tx queries a transaction by hash
+	Tx(ctx context.Context, in *QueryTxRequest, opts ...grpc.CallOption) (*QueryTxResponse, error)
+	// TxsByHashes queries the transactions of given hashes at once
+	TxsByHashes(ctx context.Context, in *QueryTxsByHashesRequest, opts ...grpc.CallOption) (*QueryTxsByHashesResponse, error)
+	// TxBySignerSequence queries a transaction by its signer and the account
+	// sequence of the signer
+	TxBySignerSequence(ctx context.Context, in *QueryTxBySignerSequenceRequest, opts ...grpc.CallOption) (*QueryTxResponse, error)
+	// Txs queries all transactions with pagination
+	Txs(ctx context.Context, in *QueryTxsRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error)
+	// TxsByAccount queries all transactions of given account
+	TxsByAccount(ctx context.Context, in *QueryTxsByAccountRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error)
+	// AccountSummary queries the activity summary of given account
+	AccountSummary(ctx context.Context, in *QueryAccountSummaryRequest, opts ...grpc.CallOption) (*QueryAccountSummaryResponse, error)
+	// TxsByHeight queries all transactions of given height
+	TxsByHeight(ctx context.Context, in *QueryTxsByHeightRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error)
+	// TxsByEvents queries all transactions matching the given event query
+	TxsByEvents(ctx context.Context, in *QueryTxsByEventsRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error)
+	// TxsByMsgType queries all transactions having a message of given type
+	TxsByMsgType(ctx context.Context, in *QueryTxsByMsgTypeRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TxCount(ctx context.Context, in *QueryTxCountRequest, opts ...grpc.CallOption) (*QueryTxCountResponse, error) {
+	out := new(QueryTxCountResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/TxCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tx(ctx context.Context, in *QueryTxRequest, opts ...grpc.CallOption) (*QueryTxResponse, error) {
+	out := new(QueryTxResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/Tx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxsByHashes(ctx context.Context, in *QueryTxsByHashesRequest, opts ...grpc.CallOption) (*QueryTxsByHashesResponse, error) {
+	out := new(QueryTxsByHashesResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/TxsByHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxBySignerSequence(ctx context.Context, in *QueryTxBySignerSequenceRequest, opts ...grpc.CallOption) (*QueryTxResponse, error) {
+	out := new(QueryTxResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/TxBySignerSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Txs(ctx context.Context, in *QueryTxsRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error) {
+	out := new(QueryTxsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/Txs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxsByAccount(ctx context.Context, in *QueryTxsByAccountRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error) {
+	out := new(QueryTxsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/TxsByAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountSummary(ctx context.Context, in *QueryAccountSummaryRequest, opts ...grpc.CallOption) (*QueryAccountSummaryResponse, error) {
+	out := new(QueryAccountSummaryResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/AccountSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxsByHeight(ctx context.Context, in *QueryTxsByHeightRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error) {
+	out := new(QueryTxsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/TxsByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxsByEvents(ctx context.Context, in *QueryTxsByEventsRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error) {
+	out := new(QueryTxsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/TxsByEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxsByMsgType(ctx context.Context, in *QueryTxsByMsgTypeRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error) {
+	out := new(QueryTxsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.tx.v1.Query/TxsByMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TxsCount queries the total number of transactions
+	TxCount(context.Context, *QueryTxCountRequest) (*QueryTxCountResponse, error)
+	// Tx queries a transaction by hash
+	Tx(context.Context, *QueryTxRequest) (*QueryTxResponse, error)
+	// TxsByHashes queries the transactions of given hashes at once
+	TxsByHashes(context.Context, *QueryTxsByHashesRequest) (*QueryTxsByHashesResponse, error)
+	// TxBySignerSequence queries a transaction by its signer and the account
+	// sequence of the signer
+	TxBySignerSequence(context.Context, *QueryTxBySignerSequenceRequest) (*QueryTxResponse, error)
+	// Txs queries all transactions with pagination
+	Txs(context.Context, *QueryTxsRequest) (*QueryTxsResponse, error)
+	// TxsByAccount queries all transactions of given account
+	TxsByAccount(context.Context, *QueryTxsByAccountRequest) (*QueryTxsResponse, error)
+	// AccountSummary queries the activity summary of given account
+	AccountSummary(context.Context, *QueryAccountSummaryRequest) (*QueryAccountSummaryResponse, error)
+	// TxsByHeight queries all transactions of given height
+	TxsByHeight(context.Context, *QueryTxsByHeightRequest) (*QueryTxsResponse, error)
+	// TxsByEvents queries all transactions matching the given event query
+	TxsByEvents(context.Context, *QueryTxsByEventsRequest) (*QueryTxsResponse, error)
+	// TxsByMsgType queries all transactions having a message of given type
+	TxsByMsgType(context.Context, *QueryTxsByMsgTypeRequest) (*QueryTxsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TxCount(ctx context.Context, req *QueryTxCountRequest) (*QueryTxCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxCount not implemented")
+}
+func (*UnimplementedQueryServer) Tx(ctx context.Context, req *QueryTxRequest) (*QueryTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tx not implemented")
+}
+func (*UnimplementedQueryServer) TxsByHashes(ctx context.Context, req *QueryTxsByHashesRequest) (*QueryTxsByHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxsByHashes not implemented")
+}
+func (*UnimplementedQueryServer) TxBySignerSequence(ctx context.Context, req *QueryTxBySignerSequenceRequest) (*QueryTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxBySignerSequence not implemented")
+}
+func (*UnimplementedQueryServer) Txs(ctx context.Context, req *QueryTxsRequest) (*QueryTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Txs not implemented")
+}
+func (*UnimplementedQueryServer) TxsByAccount(ctx context.Context, req *QueryTxsByAccountRequest) (*QueryTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxsByAccount not implemented")
+}
+func (*UnimplementedQueryServer) AccountSummary(ctx context.Context, req *QueryAccountSummaryRequest) (*QueryAccountSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountSummary not implemented")
+}
+func (*UnimplementedQueryServer) TxsByHeight(ctx context.Context, req *QueryTxsByHeightRequest) (*QueryTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxsByHeight not implemented")
+}
+func (*UnimplementedQueryServer) TxsByEvents(ctx context.Context, req *QueryTxsByEventsRequest) (*QueryTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxsByEvents not implemented")
+}
+func (*UnimplementedQueryServer) TxsByMsgType(ctx context.Context, req *QueryTxsByMsgTypeRequest) (*QueryTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxsByMsgType not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TxCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/TxCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxCount(ctx, req.(*QueryTxCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/Tx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tx(ctx, req.(*QueryTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxsByHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxsByHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxsByHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/TxsByHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxsByHashes(ctx, req.(*QueryTxsByHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxBySignerSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxBySignerSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxBySignerSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/TxBySignerSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxBySignerSequence(ctx, req.(*QueryTxBySignerSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Txs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Txs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/Txs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Txs(ctx, req.(*QueryTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxsByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/TxsByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxsByAccount(ctx, req.(*QueryTxsByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/AccountSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountSummary(ctx, req.(*QueryAccountSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxsByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxsByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxsByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/TxsByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxsByHeight(ctx, req.(*QueryTxsByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxsByEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxsByEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxsByEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/TxsByEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxsByEvents(ctx, req.(*QueryTxsByEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxsByMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxsByMsgTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxsByMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.tx.v1.Query/TxsByMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxsByMsgType(ctx, req.(*QueryTxsByMsgTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "indexer.tx.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TxCount",
+			Handler:    _Query_TxCount_Handler,
+		},
+		{
+			MethodName: "Tx",
+			Handler:    _Query_Tx_Handler,
+		},
+		{
+			MethodName: "TxsByHashes",
+			Handler:    _Query_TxsByHashes_Handler,
+		},
+		{
+			MethodName: "TxBySignerSequence",
+			Handler:    _Query_TxBySignerSequence_Handler,
+		},
+		{
+			MethodName: "Txs",
+			Handler:    _Query_Txs_Handler,
+		},
+		{
+			MethodName: "TxsByAccount",
+			Handler:    _Query_TxsByAccount_Handler,
+		},
+		{
+			MethodName: "AccountSummary",
+			Handler:    _Query_AccountSummary_Handler,
+		},
+		{
+			MethodName: "TxsByHeight",
+			Handler:    _Query_TxsByHeight_Handler,
+		},
+		{
+			MethodName: "TxsByEvents",
+			Handler:    _Query_TxsByEvents_Handler,
+		},
+		{
+			MethodName: "TxsByMsgType",
+			Handler:    _Query_TxsByMsgType_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "indexer/tx/v1/query.proto",
+}
+
+func (m *QueryTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxsByHashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxsByHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxsByHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxsByHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxsByHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxsByHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingTxHashes) > 0 {
+		for iNdEx := len(m.MissingTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingTxHashes[iNdEx])
+			copy(dAtA[i:], m.MissingTxHashes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MissingTxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxBySignerSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxBySignerSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxBySignerSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OrderBy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderBy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ToTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ToTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ToTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FromTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.FromTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.FromTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxsByAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxsByAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxsByAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.OrderBy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderBy))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ToTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ToTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ToTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FromTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.FromTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.FromTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexedFromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexedFromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Summary != nil {
+		{
+			size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesPaid) > 0 {
+		for iNdEx := len(m.FeesPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastSeenTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSeenTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x3a
+	if m.LastSeenHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastSeenHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FirstSeenTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FirstSeenTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x2a
+	if m.FirstSeenHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FirstSeenHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FailedTxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedTxCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxsByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxsByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxsByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxsByEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxsByEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxsByEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxsByMsgTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxsByMsgTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxsByMsgTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxsByHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for _, s := range m.TxHashes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxsByHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MissingTxHashes) > 0 {
+		for _, s := range m.MissingTxHashes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxBySignerSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.FromTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.FromTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ToTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ToTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OrderBy != 0 {
+		n += 1 + sovQuery(uint64(m.OrderBy))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryTxsByAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.FromTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.FromTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ToTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ToTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OrderBy != 0 {
+		n += 1 + sovQuery(uint64(m.OrderBy))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryAccountSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Summary != nil {
+		l = m.Summary.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IndexedFromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.IndexedFromHeight))
+	}
+	return n
+}
+
+func (m *AccountSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovQuery(uint64(m.TxCount))
+	}
+	if m.FailedTxCount != 0 {
+		n += 1 + sovQuery(uint64(m.FailedTxCount))
+	}
+	if m.FirstSeenHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FirstSeenHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FirstSeenTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastSeenHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastSeenHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSeenTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.FeesPaid) > 0 {
+		for _, e := range m.FeesPaid {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxsByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxsByEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxsByMsgTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &types.TxResponse{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxsByHashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxsByHashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxsByHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxsByHashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxsByHashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxsByHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &types.TxResponse{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingTxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingTxHashes = append(m.MissingTxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxBySignerSequenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxBySignerSequenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxBySignerSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FromTime == nil {
+				m.FromTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.FromTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ToTime == nil {
+				m.ToTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ToTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			m.OrderBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBy |= tx.OrderBy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxsByAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxsByAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxsByAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= TxRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FromTime == nil {
+				m.FromTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.FromTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ToTime == nil {
+				m.ToTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ToTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			m.OrderBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBy |= tx.OrderBy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Summary == nil {
+				m.Summary = &AccountSummary{}
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedFromHeight", wireType)
+			}
+			m.IndexedFromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedFromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTxCount", wireType)
+			}
+			m.FailedTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeenHeight", wireType)
+			}
+			m.FirstSeenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstSeenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeenTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FirstSeenTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenHeight", wireType)
+			}
+			m.LastSeenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastSeenTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesPaid = append(m.FeesPaid, types.Coin{})
+			if err := m.FeesPaid[len(m.FeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxsByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxsByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxsByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxsByEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxsByEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxsByEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxsByMsgTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxsByMsgTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxsByMsgTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &types.TxResponse{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,93 @@
+package tx
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const (
+	// bech32RegexFmt matches 20 and 32 bytes bech32 addresses of the hrp.
+	// the longer one comes first as the alternation is leftmost-first.
+	bech32RegexFmt  = `\b%s1(?:[a-z0-9]{58}|[a-z0-9]{38})\b`
+	initHexRegexStr = "0x(?:[a-f1-9][a-f0-9]*){1,64}"
+)
+
+var (
+	initHexRegex = regexp.MustCompile(initHexRegexStr)
+)
+
+// newBech32Regex returns the regex matching the bech32 account addresses of the address codec
+func newBech32Regex(ac address.Codec) (*regexp.Regexp, error) {
+	addr, err := ac.BytesToString(make([]byte, 20))
+	if err != nil {
+		return nil, err
+	}
+
+	hrp, _, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return regexp.Compile(fmt.Sprintf(bech32RegexFmt, regexp.QuoteMeta(hrp)))
+}
+
+// NewAddressGrep returns the function finding the bech32 addresses of the address codec and the
+// hex addresses in a string, as the Indexer greps the event attributes
+func NewAddressGrep(ac address.Codec) (func(string) []string, error) {
+	bech32Regex, err := newBech32Regex(ac)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(str string) []string {
+		return append(bech32Regex.FindAllString(str, -1), findAllHexAddress(str)...)
+	}, nil
+}
+
+func findAllHexAddress(str string) []string {
+	return initHexRegex.FindAllString(str, -1)
+}
+
+// AccAddressFromString parses the bech32 address with the address codec or the 0x prefixed hex address.
+// use it because i want to make this submodule not depend on move vm/module
+func AccAddressFromString(ac address.Codec, addrStr string) (addr sdk.AccAddress, err error) {
+	if strings.HasPrefix(addrStr, "0x") {
+		addrStr = strings.TrimPrefix(addrStr, "0x")
+
+		// add padding
+		if len(addrStr) <= 40 {
+			addrStr = strings.Repeat("0", 40-len(addrStr)) + addrStr
+		} else if len(addrStr) <= 64 {
+			addrStr = strings.Repeat("0", 64-len(addrStr)) + addrStr
+		} else {
+			return nil, fmt.Errorf("invalid address string: %s", addrStr)
+		}
+
+		if addr, err = hex.DecodeString(addrStr); err != nil {
+			return
+		}
+	} else if addr, err = ac.StringToBytes(addrStr); err != nil {
+		return
+	}
+
+	return
+}
+
+// NormalizeHash returns the 32 bytes hash, such as the EVM tx hash, as 0x prefixed lowercase hex
+func NormalizeHash(s string) (string, error) {
+	s = strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	if len(bz) != 32 {
+		return "", fmt.Errorf("invalid hash length: %d", len(bz))
+	}
+	return "0x" + hex.EncodeToString(bz), nil
+}
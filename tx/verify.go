@@ -0,0 +1,97 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	cosmoserr "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	kvindexer "github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+// verify checks that every index points to a stored tx and account sequences are consistent.
+func (sub Indexer) verify(ctx context.Context) ([]kvindexer.InvariantViolation, error) {
+	violations := []kvindexer.InvariantViolation{}
+
+	// txhashesByHeightMap -> txMap
+	err := sub.txhashesByHeightMap.Walk(ctx, nil, func(key collections.Pair[int64, uint64], txHash string) (bool, error) {
+		txr, err := sub.txMap.Get(ctx, txHash)
+		if err != nil {
+			if !cosmoserr.IsOf(err, collections.ErrNotFound) {
+				return true, err
+			}
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "txs_by_height",
+				Description: fmt.Sprintf("tx %s at height %d, index %d not found", txHash, key.K1(), key.K2()),
+			})
+			return false, nil
+		}
+
+		if txr.Height != key.K1() {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "txs_by_height",
+				Description: fmt.Sprintf("tx %s indexed at height %d has height %d", txHash, key.K1(), txr.Height),
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// txhashesBySequenceMap -> txMap
+	err = sub.txhashesBySequenceMap.Walk(ctx, nil, func(seq uint64, txHash string) (bool, error) {
+		found, err := sub.txMap.Has(ctx, txHash)
+		if err != nil {
+			return true, err
+		}
+		if !found {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "tx_sequences",
+				Description: fmt.Sprintf("tx %s at sequence %d not found", txHash, seq),
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// txhashesByAccountMap -> txMap, accountSequenceMap
+	err = sub.txhashesByAccountMap.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], txHash string) (bool, error) {
+		account, err := sub.ac.BytesToString(key.K1())
+		if err != nil {
+			account = fmt.Sprintf("%X", []byte(key.K1()))
+		}
+
+		found, err := sub.txMap.Has(ctx, txHash)
+		if err != nil {
+			return true, err
+		}
+		if !found {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "txs_by_account",
+				Description: fmt.Sprintf("tx %s of account %s at sequence %d not found", txHash, account, key.K2()),
+			})
+		}
+
+		accSeq, err := sub.accountSequenceMap.Get(ctx, key.K1())
+		if err != nil && !cosmoserr.IsOf(err, collections.ErrNotFound) {
+			return true, err
+		}
+		if key.K2() >= accSeq {
+			violations = append(violations, kvindexer.InvariantViolation{
+				Invariant:   "account_sequences",
+				Description: fmt.Sprintf("sequence %d of account %s is not less than the account sequence %d", key.K2(), account, accSeq),
+			})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return violations, nil
+}
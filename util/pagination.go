@@ -0,0 +1,200 @@
+package util
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// CollectionRangePaginate paginates the entries of the map within [start, end).
+// Unlike query.CollectionPaginate, the iteration begins at the bound of the range,
+// so the entries out of the range are not scanned. A nil bound means unbounded.
+// A nil predicateFunc means no filtering is applied as query.CollectionFilteredPaginate.
+// The page key is clamped to the range, so that a crafted or stale key doesn't walk out of it.
+// The total is counted if CountTotal is set without the page key as query.CollectionPaginate does.
+// The next key of the page response is the encoded key of the map.
+func CollectionRangePaginate[K, V, T any](
+	ctx context.Context,
+	m *collections.Map[K, V],
+	start, end *K,
+	pageReq *query.PageRequest,
+	predicateFunc func(key K, value V) (bool, error),
+	transformFunc func(key K, value V) (T, error),
+) ([]T, *query.PageResponse, error) {
+	var (
+		offset     uint64
+		limit      uint64 = query.DefaultLimit
+		reverse    bool
+		countTotal bool
+		key        []byte
+	)
+	if pageReq != nil {
+		offset, key, reverse, countTotal = pageReq.Offset, pageReq.Key, pageReq.Reverse, pageReq.CountTotal
+		if pageReq.Limit > 0 {
+			limit = pageReq.Limit
+		}
+	}
+
+	kc := m.KeyCodec()
+
+	// the page key narrows the range from the side the iteration begins, within the range only
+	endInclusive := false
+	if len(key) != 0 {
+		_, k, err := kc.Decode(key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if reverse {
+			narrows := end == nil
+			if !narrows {
+				cmp, err := compareKeys(kc, k, *end)
+				if err != nil {
+					return nil, nil, err
+				}
+				narrows = cmp < 0
+			}
+			if narrows {
+				end, endInclusive = &k, true
+			}
+		} else {
+			narrows := start == nil
+			if !narrows {
+				cmp, err := compareKeys(kc, k, *start)
+				if err != nil {
+					return nil, nil, err
+				}
+				narrows = cmp > 0
+			}
+			if narrows {
+				start = &k
+			}
+		}
+		offset, countTotal = 0, false
+	}
+
+	if start != nil && end != nil {
+		cmp, err := compareKeys(kc, *start, *end)
+		if err != nil {
+			return nil, nil, err
+		}
+		if cmp > 0 || (cmp == 0 && !endInclusive) {
+			return nil, &query.PageResponse{}, nil
+		}
+	}
+
+	rng := new(collections.Range[K])
+	if start != nil {
+		rng = rng.StartInclusive(*start)
+	}
+	if end != nil && endInclusive {
+		rng = rng.EndInclusive(*end)
+	} else if end != nil {
+		rng = rng.EndExclusive(*end)
+	}
+	if reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := m.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var results []T
+	// total is the number of the entries matched so far, including the ones skipped by the offset
+	var total uint64
+	pageRes := &query.PageResponse{}
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if predicateFunc != nil {
+			include, err := predicateFunc(kv.Key, kv.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !include {
+				continue
+			}
+		}
+
+		total++
+		if total <= offset {
+			continue
+		}
+
+		if uint64(len(results)) == limit {
+			if pageRes.NextKey == nil {
+				pageRes.NextKey = make([]byte, kc.Size(kv.Key))
+				if _, err = kc.Encode(pageRes.NextKey, kv.Key); err != nil {
+					return nil, nil, err
+				}
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+
+		transformed, err := transformFunc(kv.Key, kv.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+		results = append(results, transformed)
+	}
+	if countTotal {
+		pageRes.Total = total
+	}
+
+	return results, pageRes, nil
+}
+
+// IndexRangePaginate paginates the values of the map referenced by the keys of the index within [start, end).
+// pos returns the key of the map from the key of the index. A nil predicateFunc means no filtering is applied.
+// The next key of the page response is the encoded key of the index.
+func IndexRangePaginate[IK, K, V any](
+	ctx context.Context,
+	index *collections.Map[IK, bool],
+	m *collections.Map[K, V],
+	start, end *IK,
+	pageReq *query.PageRequest,
+	pos func(key IK) K,
+	predicateFunc func(value V) (bool, error),
+) ([]V, *query.PageResponse, error) {
+	// the value read by the predicate is returned by the transform of the same key
+	var value V
+	return CollectionRangePaginate(ctx, index, start, end, pageReq,
+		func(key IK, _ bool) (bool, error) {
+			var err error
+			if value, err = m.Get(ctx, pos(key)); err != nil {
+				return false, err
+			}
+			if predicateFunc == nil {
+				return true, nil
+			}
+			return predicateFunc(value)
+		},
+		func(IK, bool) (V, error) {
+			return value, nil
+		},
+	)
+}
+
+func compareKeys[K any](kc collcodec.KeyCodec[K], a, b K) (int, error) {
+	bzA := make([]byte, kc.Size(a))
+	if _, err := kc.Encode(bzA, a); err != nil {
+		return 0, err
+	}
+	bzB := make([]byte, kc.Size(b))
+	if _, err := kc.Encode(bzB, b); err != nil {
+		return 0, err
+	}
+	return bytes.Compare(bzA, bzB), nil
+}
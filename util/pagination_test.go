@@ -0,0 +1,84 @@
+package util
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/dbadapter"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/initia-labs/kvindexer/store"
+)
+
+func TestCollectionRangePaginate(t *testing.T) {
+	kvStore := store.NewCacheStore(dbadapter.Store{DB: dbm.NewMemDB()}, 1<<20)
+	sb := collections.NewSchemaBuilderFromAccessor(func(context.Context) corestore.KVStore { return kvStore })
+	m := collections.NewMap(sb, collections.NewPrefix(0), "values", collections.Uint64Key, collections.Uint64Value)
+	if _, err := sb.Build(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for i := uint64(1); i <= 10; i++ {
+		if err := m.Set(ctx, i, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pageKey := func(k uint64) []byte {
+		bz := make([]byte, collections.Uint64Key.Size(k))
+		if _, err := collections.Uint64Key.Encode(bz, k); err != nil {
+			t.Fatal(err)
+		}
+		return bz
+	}
+	even := func(k, _ uint64) (bool, error) { return k%2 == 0, nil }
+
+	// the range is [3, 8)
+	tests := []struct {
+		name      string
+		pageReq   *query.PageRequest
+		predicate func(k, v uint64) (bool, error)
+		want      []uint64
+		nextKey   []byte
+		total     uint64
+	}{
+		{"all", nil, nil, []uint64{3, 4, 5, 6, 7}, nil, 0},
+		{"limit", &query.PageRequest{Limit: 2}, nil, []uint64{3, 4}, pageKey(5), 0},
+		{"offset", &query.PageRequest{Offset: 3, Limit: 1}, nil, []uint64{6}, pageKey(7), 0},
+		{"count total", &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true}, nil, []uint64{4, 5}, pageKey(6), 5},
+		{"count total of the filtered", &query.PageRequest{Limit: 1, CountTotal: true}, even, []uint64{4}, pageKey(6), 2},
+		{"page key", &query.PageRequest{Key: pageKey(6)}, nil, []uint64{6, 7}, nil, 0},
+		{"page key ignores the offset and count total", &query.PageRequest{Key: pageKey(6), Offset: 1, CountTotal: true}, nil, []uint64{6, 7}, nil, 0},
+		{"page key below the range", &query.PageRequest{Key: pageKey(1)}, nil, []uint64{3, 4, 5, 6, 7}, nil, 0},
+		{"page key above the range", &query.PageRequest{Key: pageKey(9)}, nil, nil, nil, 0},
+		{"reverse", &query.PageRequest{Reverse: true, Limit: 2}, nil, []uint64{7, 6}, pageKey(5), 0},
+		{"reverse page key", &query.PageRequest{Reverse: true, Key: pageKey(5)}, nil, []uint64{5, 4, 3}, nil, 0},
+		{"reverse page key above the range", &query.PageRequest{Reverse: true, Key: pageKey(10)}, nil, []uint64{7, 6, 5, 4, 3}, nil, 0},
+		{"reverse page key at the end", &query.PageRequest{Reverse: true, Key: pageKey(8)}, nil, []uint64{7, 6, 5, 4, 3}, nil, 0},
+		{"reverse page key below the range", &query.PageRequest{Reverse: true, Key: pageKey(2)}, nil, nil, nil, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end := uint64(3), uint64(8)
+			got, pageRes, err := CollectionRangePaginate(ctx, &m, &start, &end, tc.pageReq, tc.predicate,
+				func(_, v uint64) (uint64, error) { return v, nil })
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if !slices.Equal(pageRes.NextKey, tc.nextKey) {
+				t.Errorf("got next key %X, want %X", pageRes.NextKey, tc.nextKey)
+			}
+			if pageRes.Total != tc.total {
+				t.Errorf("got total %d, want %d", pageRes.Total, tc.total)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+
+	"github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+var _ types.AdminServer = (*AdminServer)(nil)
+
+type AdminServer struct {
+	*Keeper
+
+	logger log.Logger
+}
+
+// NewAdminServer return new AdminServer instance
+func NewAdminServer(k *Keeper, logger log.Logger) AdminServer {
+	return AdminServer{Keeper: k, logger: logger}
+}
+
+// StartAdminServer starts the admin gRPC service if it's enabled. It's stopped by Close.
+func (k *Keeper) StartAdminServer(logger log.Logger) error {
+	if !k.config.IsEnabled() || !k.config.AdminEnable {
+		return nil
+	}
+
+	cdc, ok := k.cdc.(interface{ GRPCCodec() encoding.Codec })
+	if !ok {
+		return errors.New("codec doesn't support grpc")
+	}
+
+	lis, err := net.Listen("tcp", k.config.AdminAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen admin address: %w", err)
+	}
+
+	srv := grpc.NewServer(grpc.ForceServerCodec(cdc.GRPCCodec()))
+	types.RegisterAdminServer(srv, NewAdminServer(k, logger))
+	k.adminServer = srv
+
+	go func() {
+		logger.Info("starting indexer admin service", "address", k.config.AdminAddress)
+		if err := srv.Serve(lis); err != nil {
+			logger.Error("failed to serve indexer admin service", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// context returns the sdk context for the operations not bound to the chain state.
+func (s AdminServer) context() context.Context {
+	return sdk.Context{}.WithContext(context.Background()).WithLogger(s.logger)
+}
+
+// Prune implements types.AdminServer.
+func (s AdminServer) Prune(_ context.Context, req *types.AdminPruneRequest) (*types.AdminPruneResponse, error) {
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+	if req.Height > s.committedHeight.Load() {
+		return nil, status.Errorf(codes.InvalidArgument, "height must not be greater than the committed height %d", s.committedHeight.Load())
+	}
+
+	if running := s.pruningRunning.Swap(true); running {
+		return nil, status.Error(codes.Unavailable, "pruning is already running")
+	}
+	defer s.pruningRunning.Store(false)
+
+	s.pruneTo(s.context(), req.Height)
+	return &types.AdminPruneResponse{}, nil
+}
+
+// Pause implements types.AdminServer.
+func (s AdminServer) Pause(context.Context, *types.AdminPauseRequest) (*types.AdminPauseResponse, error) {
+	s.paused.Store(true)
+	s.logger.Info("indexer paused")
+	return &types.AdminPauseResponse{}, nil
+}
+
+// Resume implements types.AdminServer.
+func (s AdminServer) Resume(context.Context, *types.AdminResumeRequest) (*types.AdminResumeResponse, error) {
+	if s.jobs.isRunning(jobTypeReindex) {
+		return nil, status.Error(codes.FailedPrecondition, "reindex job is running")
+	}
+
+	s.paused.Store(false)
+	s.logger.Info("indexer resumed")
+	return &types.AdminResumeResponse{}, nil
+}
+
+// Flush implements types.AdminServer.
+func (s AdminServer) Flush(_ context.Context, req *types.AdminFlushRequest) (*types.AdminFlushResponse, error) {
+	s.flush()
+
+	if req.Compact {
+		db, ok := s.db.(interface {
+			ForceCompact(start, limit []byte) error
+		})
+		if !ok {
+			return nil, status.Error(codes.Unimplemented, "compaction is not supported by the backend")
+		}
+		if err := db.ForceCompact(nil, nil); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &types.AdminFlushResponse{}, nil
+}
+
+// StartReindex implements types.AdminServer.
+func (s AdminServer) StartReindex(_ context.Context, req *types.AdminStartReindexRequest) (*types.AdminJobResponse, error) {
+	if s.reindexSource == nil {
+		return nil, status.Error(codes.FailedPrecondition, "reindex source is not set")
+	}
+	if !s.IsPaused() {
+		return nil, status.Error(codes.FailedPrecondition, "indexer must be paused to reindex")
+	}
+	if req.FromHeight <= 0 || req.FromHeight > req.ToHeight {
+		return nil, status.Error(codes.InvalidArgument, "invalid height range")
+	}
+	if req.ToHeight > s.latestHeight.Load() {
+		return nil, status.Errorf(codes.InvalidArgument, "to height must not be greater than the latest height %d", s.latestHeight.Load())
+	}
+	if s.jobs.isRunning(jobTypeReindex) {
+		return nil, status.Error(codes.Unavailable, "reindex job is already running")
+	}
+
+	submodules := []types.Submodule{}
+	for _, svc := range s.submodules {
+		if len(req.Submodules) == 0 || contains(req.Submodules, svc.Name()) {
+			submodules = append(submodules, svc)
+		}
+	}
+	if len(submodules) != len(req.Submodules) && len(req.Submodules) != 0 {
+		return nil, status.Error(codes.NotFound, "submodule not found")
+	}
+
+	// replaying an indexed height duplicates its data, as not every index is idempotent
+	for _, svc := range submodules {
+		if indexed := s.indexedHeights[svc.Name()].Load(); req.FromHeight <= indexed {
+			return nil, status.Errorf(codes.InvalidArgument, "submodule %s is already indexed up to height %d", svc.Name(), indexed)
+		}
+	}
+
+	job := s.jobs.start(types.Job{
+		Type:       jobTypeReindex,
+		FromHeight: req.FromHeight,
+		ToHeight:   req.ToHeight,
+	}, func(update func(func(*types.Job))) error {
+		return s.reindex(req.FromHeight, req.ToHeight, submodules, update)
+	})
+
+	return &types.AdminJobResponse{Job: &job}, nil
+}
+
+// reindex replays the blocks in the height range for the submodules and commits them height by height.
+// The committed height advances only if all the submodules are reindexed.
+func (s AdminServer) reindex(fromHeight, toHeight int64, submodules []types.Submodule, update func(func(*types.Job))) error {
+	all := len(submodules) == len(s.submodules)
+	for height := fromHeight; height <= toHeight; height++ {
+		if !s.IsPaused() {
+			return errors.New("indexer is resumed while reindexing")
+		}
+
+		ctx, err := s.reindexSource.QueryContext(height)
+		if err != nil {
+			return fmt.Errorf("failed to get context of height %d: %w", height, err)
+		}
+
+		req, res, err := s.reindexSource.FinalizeBlock(height)
+		if err != nil {
+			return fmt.Errorf("failed to get block of height %d: %w", height, err)
+		}
+
+		s.beginBlock()
+		if err := s.reindexBlock(ctx, req, res, submodules, all); err != nil {
+			s.abortBlock()
+			return err
+		}
+		s.commitBlock()
+
+		if all {
+			s.committedHeight.Store(height)
+		}
+		update(func(job *types.Job) {
+			job.CurrentHeight = height
+		})
+	}
+
+	return nil
+}
+
+// reindexBlock replays the block for the submodules in the block begun by the caller.
+func (s AdminServer) reindexBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock, submodules []types.Submodule, all bool) error {
+	for _, svc := range submodules {
+		if err := svc.FinalizeBlock(ctx, req, res); err != nil {
+			return fmt.Errorf("failed to reindex height %d of submodule %s: %w", req.Height, svc.Name(), err)
+		}
+	}
+	for _, svc := range submodules {
+		if err := svc.Commit(ctx, abci.ResponseCommit{}, nil); err != nil {
+			return fmt.Errorf("failed to commit height %d of submodule %s: %w", req.Height, svc.Name(), err)
+		}
+	}
+	if all {
+		if err := s.committedHeightItem.Set(ctx, req.Height); err != nil {
+			return fmt.Errorf("failed to store committed height %d: %w", req.Height, err)
+		}
+	}
+
+	for _, svc := range submodules {
+		s.indexedHeights[svc.Name()].Store(req.Height)
+	}
+	return nil
+}
+
+// StartReconcile implements types.AdminServer.
+func (s AdminServer) StartReconcile(_ context.Context, req *types.AdminStartReconcileRequest) (*types.AdminJobResponse, error) {
+	if s.reindexSource == nil {
+		return nil, status.Error(codes.FailedPrecondition, "reindex source is not set")
+	}
+	if req.Submodule != "" && !s.hasSubmodule(req.Submodule) {
+		return nil, status.Error(codes.NotFound, "submodule not found")
+	}
+	if s.jobs.isRunning(jobTypeReconcile) {
+		return nil, status.Error(codes.Unavailable, "reconcile job is already running")
+	}
+
+	job := s.startReconcile(req.Submodule)
+	return &types.AdminJobResponse{Job: &job}, nil
+}
+
+// StartVerify implements types.AdminServer.
+func (s AdminServer) StartVerify(_ context.Context, req *types.AdminStartVerifyRequest) (*types.AdminJobResponse, error) {
+	if req.Submodule != "" && !s.hasSubmodule(req.Submodule) {
+		return nil, status.Error(codes.NotFound, "submodule not found")
+	}
+	if s.jobs.isRunning(jobTypeVerify) {
+		return nil, status.Error(codes.Unavailable, "verify job is already running")
+	}
+
+	job := s.jobs.start(types.Job{Type: jobTypeVerify}, func(update func(func(*types.Job))) error {
+		results, err := s.Verify(s.context(), req.Submodule)
+		if err != nil {
+			return err
+		}
+
+		update(func(job *types.Job) {
+			job.Verifications = results
+		})
+		return nil
+	})
+
+	return &types.AdminJobResponse{Job: &job}, nil
+}
+
+// Job implements types.AdminServer.
+func (s AdminServer) Job(_ context.Context, req *types.AdminJobRequest) (*types.AdminJobResponse, error) {
+	job, found := s.jobs.get(req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+
+	return &types.AdminJobResponse{Job: &job}, nil
+}
+
+// Jobs implements types.AdminServer.
+func (s AdminServer) Jobs(context.Context, *types.AdminJobsRequest) (*types.AdminJobsResponse, error) {
+	res := []*types.Job{}
+	for _, job := range s.jobs.list() {
+		res = append(res, &job)
+	}
+
+	return &types.AdminJobsResponse{Jobs: res}, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
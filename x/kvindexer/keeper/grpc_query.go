@@ -29,6 +29,11 @@ func (q Querier) Versions(context.Context, *types.QueryVersionRequest) (*types.Q
 	return &types.QueryVersionResponse{Versions: res}, nil
 }
 
+// Health implements types.QueryServer.
+func (q Querier) Health(ctx context.Context, _ *types.QueryHealthRequest) (*types.QueryHealthResponse, error) {
+	return q.Keeper.Health(ctx), nil
+}
+
 // NewQuerier return new Querier instance
 func NewQuerier(k *Keeper) Querier {
 	return Querier{k}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"runtime/debug"
+	"sync/atomic"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/initia-labs/kvindexer/x/kvindexer/types"
 	"github.com/pkg/errors"
 )
@@ -30,6 +34,10 @@ func (k *Keeper) Start(ctxMap map[string]context.Context) (err error) {
 		return nil
 	}
 
+	if err = k.restoreHeights(context.Background()); err != nil {
+		return errors.Wrap(err, "failed to restore committed height")
+	}
+
 	for _, svc := range k.submodules {
 		if err = svc.Initialize(ctxMap[svc.Name()]); err != nil {
 			return errors.Wrap(err, fmt.Sprintf("failed to initialize submodule %s", svc.Name()))
@@ -39,6 +47,26 @@ func (k *Keeper) Start(ctxMap map[string]context.Context) (err error) {
 	return nil
 }
 
+// restoreHeights loads the committed height persisted before the restart, so that the health check
+// doesn't report the indexer as behind until the next commit. The submodules are assumed to be indexed up to it.
+func (k *Keeper) restoreHeights(ctx context.Context) error {
+	height, err := k.committedHeightItem.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	k.latestHeight.Store(height)
+	k.committedHeight.Store(height)
+	for _, indexed := range k.indexedHeights {
+		indexed.Store(height)
+	}
+
+	return nil
+}
+
 func (k Keeper) Validate() error {
 	if k.config.IsEnabled() {
 		if k.db == nil {
@@ -69,6 +97,8 @@ func (k *Keeper) RegisterSubmodules(submodules ...types.Submodule) error {
 		registeredNames[registered.Name()] = true
 
 		k.submodules = append(k.submodules, registered)
+		k.indexedHeights[registered.Name()] = &atomic.Int64{}
+		k.failedHeights[registered.Name()] = &atomic.Int64{}
 	}
 
 	return nil
@@ -87,10 +117,19 @@ func (k *Keeper) HandleFinalizeBlock(ctx context.Context, req abci.RequestFinali
 		}
 	}()
 
-	for name, svc := range k.submodules {
-		if err = svc.FinalizeBlock(ctx, req, res); err != nil {
-			k.Logger(ctx).Warn("failed to handle finalize block event", "submodule", name)
-		}
+	// the lag keeps growing while paused or failing, as the indexed heights are not updated
+	defer k.setLagGauges(req.Height)
+
+	k.latestHeight.Store(req.Height)
+	telemetry.SetGauge(float32(req.Height), types.ModuleName, metricKeyHeight)
+
+	if k.IsPaused() {
+		return nil
+	}
+
+	k.beginBlock()
+	for _, svc := range k.submodules {
+		k.finalizeBlock(ctx, svc, req, res)
 	}
 
 	// pruning
@@ -98,11 +137,40 @@ func (k *Keeper) HandleFinalizeBlock(ctx context.Context, req abci.RequestFinali
 		k.prune(ctx, req.Height)
 	}
 
+	// reconciliation walks all the indices, so it runs in background on the committed state
+	if k.config.ReconcileInterval > 0 && req.Height%k.config.ReconcileInterval == 0 {
+		k.scheduleReconcile(ctx)
+	}
+
 	return nil
 }
 
+// finalizeBlock processes the FinalizeBlock event for the submodule.
+// A panic in the submodule is recovered so that it doesn't affect the other submodules.
+func (k *Keeper) finalizeBlock(ctx context.Context, svc types.Submodule, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) {
+	start := telemetry.Now()
+	defer func() {
+		if err := recover(); err != nil {
+			k.Logger(ctx).Error("panic in FinalizeBlock", "submodule", svc.Name(), "err", err)
+			debug.PrintStack()
+			incrSubmoduleCounter(svc.Name(), metricKeyFinalizeBlock, metricKeyPanic)
+			k.failedHeights[svc.Name()].Store(req.Height)
+		}
+		measureSubmodule(svc.Name(), start, metricKeyFinalizeBlock)
+	}()
+
+	if err := svc.FinalizeBlock(ctx, req, res); err != nil {
+		k.Logger(ctx).Warn("failed to handle finalize block event", "submodule", svc.Name(), "error", err)
+		incrSubmoduleCounter(svc.Name(), metricKeyFinalizeBlock, metricKeyError)
+		k.failedHeights[svc.Name()].Store(req.Height)
+		return
+	}
+
+	k.indexedHeights[svc.Name()].Store(req.Height)
+}
+
 func (k *Keeper) HandleCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) (err error) {
-	if !k.config.IsEnabled() {
+	if !k.config.IsEnabled() || k.IsPaused() {
 		return nil
 	}
 
@@ -113,17 +181,42 @@ func (k *Keeper) HandleCommit(ctx context.Context, res abci.ResponseCommit, chan
 		}
 	}()
 
-	for name, svc := range k.submodules {
-		if err := svc.Commit(ctx, res, changeSet); err != nil {
-			k.Logger(ctx).Warn("failed to handle commit event", "submodule", name)
-		}
+	for _, svc := range k.submodules {
+		k.commit(ctx, svc, res, changeSet)
 	}
 
-	k.store.Write()
+	height := k.latestHeight.Load()
+	if err := k.committedHeightItem.Set(ctx, height); err != nil {
+		k.Logger(ctx).Error("failed to store committed height", "height", height, "error", err)
+	}
+
+	k.commitBlock()
+	k.committedHeight.Store(height)
+	k.setCacheCounters()
+	k.setStoreSizeGauge(k.committedHeight.Load())
 
 	return nil
 }
 
+// commit processes the Commit event for the submodule.
+// A panic in the submodule is recovered so that it doesn't affect the other submodules.
+func (k *Keeper) commit(ctx context.Context, svc types.Submodule, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) {
+	start := telemetry.Now()
+	defer func() {
+		if err := recover(); err != nil {
+			k.Logger(ctx).Error("panic in Commit", "submodule", svc.Name(), "err", err)
+			debug.PrintStack()
+			incrSubmoduleCounter(svc.Name(), metricKeyCommit, metricKeyPanic)
+		}
+		measureSubmodule(svc.Name(), start, metricKeyCommit)
+	}()
+
+	if err := svc.Commit(ctx, res, changeSet); err != nil {
+		k.Logger(ctx).Warn("failed to handle commit event", "submodule", svc.Name(), "error", err)
+		incrSubmoduleCounter(svc.Name(), metricKeyCommit, metricKeyError)
+	}
+}
+
 func (k *Keeper) prune(ctx context.Context, height int64) {
 
 	if running := k.pruningRunning.Swap(true); running {
@@ -138,10 +231,24 @@ func (k *Keeper) prune(ctx context.Context, height int64) {
 			return
 		}
 
-		for _, svc := range k.submodules {
-			if err := svc.Prune(ctx, minHeight); err != nil {
-				k.Logger(ctx).Error("failed to prune", "name", svc.Name(), "error", err)
-			}
-		}
+		k.pruneTo(ctx, minHeight)
 	}(ctx, height)
 }
+
+// pruneTo removes the data of all the submodules below minHeight.
+func (k *Keeper) pruneTo(ctx context.Context, minHeight int64) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, metricKeyPrune)
+
+	// count only the keys deleted by pruning, not the ones deleted by the blocks indexed meanwhile
+	deleted := &atomic.Uint64{}
+	ctx = sdk.UnwrapSDKContext(ctx).WithValue(deletedKeysKey{}, deleted)
+
+	for _, svc := range k.submodules {
+		if err := svc.Prune(ctx, minHeight); err != nil {
+			k.Logger(ctx).Error("failed to prune", "name", svc.Name(), "error", err)
+			incrSubmoduleCounter(svc.Name(), metricKeyPrune, metricKeyError)
+		}
+	}
+
+	telemetry.IncrCounter(float32(deleted.Load()), types.ModuleName, metricKeyPrune, metricKeyDeletedKeys)
+}
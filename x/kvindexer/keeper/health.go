@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+// Health reports whether the indexer is caught up with the chain height of the context and healthy.
+func (k *Keeper) Health(ctx context.Context) *types.QueryHealthResponse {
+	res := &types.QueryHealthResponse{
+		Ready:      true,
+		Reasons:    []string{},
+		Submodules: []*types.SubmoduleHealth{},
+	}
+
+	if !k.config.IsEnabled() {
+		res.Ready = false
+		res.Reasons = append(res.Reasons, "indexer is disabled")
+		return res
+	}
+
+	if k.IsPaused() {
+		res.Reasons = append(res.Reasons, "indexer is paused")
+	}
+
+	res.ChainHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	res.IndexedHeight = k.committedHeight.Load()
+	if lag := res.ChainHeight - res.IndexedHeight; lag > k.config.MaxLag {
+		res.Reasons = append(res.Reasons, fmt.Sprintf("indexer is behind the chain by %d blocks", lag))
+	}
+
+	for _, svc := range k.submodules {
+		health := &types.SubmoduleHealth{
+			Submodule:     svc.Name(),
+			IndexedHeight: k.indexedHeights[svc.Name()].Load(),
+			FailedHeight:  k.failedHeights[svc.Name()].Load(),
+		}
+		if ms, ok := svc.(types.MigrationStatus); ok {
+			health.Migrating = ms.IsMigrating()
+		}
+		res.Submodules = append(res.Submodules, health)
+
+		if health.FailedHeight > 0 && health.FailedHeight >= res.IndexedHeight {
+			res.Reasons = append(res.Reasons, fmt.Sprintf("submodule %s failed at height %d", svc.Name(), health.FailedHeight))
+		}
+		if health.Migrating {
+			res.Reasons = append(res.Reasons, fmt.Sprintf("submodule %s is migrating", svc.Name()))
+		}
+	}
+
+	res.Ready = len(res.Reasons) == 0
+	return res
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"sync"
+	"time"
+
+	"github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+const (
+	jobTypeReindex   = "reindex"
+	jobTypeReconcile = "reconcile"
+	jobTypeVerify    = "verify"
+)
+
+// jobs keeps the background jobs started by the admin service in memory.
+type jobs struct {
+	mtx    sync.Mutex
+	nextID uint64
+	jobs   []*types.Job
+}
+
+func newJobs() *jobs {
+	return &jobs{nextID: 1}
+}
+
+// start registers the job and runs it in a goroutine.
+// run can update the job under the lock with the given function.
+func (j *jobs) start(job types.Job, run func(update func(func(*types.Job))) error) types.Job {
+	j.mtx.Lock()
+	job.Id = j.nextID
+	job.State = types.JobStateRunning
+	job.StartedAt = time.Now().UTC()
+	j.nextID++
+
+	running := &job
+	j.jobs = append(j.jobs, running)
+	started := *running
+	j.mtx.Unlock()
+
+	update := func(fn func(*types.Job)) {
+		j.mtx.Lock()
+		defer j.mtx.Unlock()
+		fn(running)
+	}
+
+	go func() {
+		err := run(update)
+		update(func(job *types.Job) {
+			finishedAt := time.Now().UTC()
+			job.FinishedAt = &finishedAt
+			job.State = types.JobStateSucceeded
+			if err != nil {
+				job.State = types.JobStateFailed
+				job.Error = err.Error()
+			}
+		})
+	}()
+
+	return started
+}
+
+// get returns a copy of the job of the given id.
+func (j *jobs) get(id uint64) (types.Job, bool) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	for _, job := range j.jobs {
+		if job.Id == id {
+			return *job, true
+		}
+	}
+	return types.Job{}, false
+}
+
+// list returns copies of all the jobs.
+func (j *jobs) list() []types.Job {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	res := make([]types.Job, 0, len(j.jobs))
+	for _, job := range j.jobs {
+		res = append(res, *job)
+	}
+	return res
+}
+
+// isRunning returns whether a job of the given type is running.
+func (j *jobs) isRunning(typ string) bool {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	for _, job := range j.jobs {
+		if job.Type == typ && job.State == types.JobStateRunning {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"sync"
 	"sync/atomic"
 
 	"cosmossdk.io/collections"
@@ -13,6 +14,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
 
 	"github.com/initia-labs/kvindexer/config"
 	"github.com/initia-labs/kvindexer/store"
@@ -40,11 +42,38 @@ type Keeper struct {
 
 	submodules []types.Submodule
 
-	pruningRunning *atomic.Bool
+	pruningRunning   *atomic.Bool
+	reconcileRunning *atomic.Bool
+	paused           *atomic.Bool
+
+	reindexSource types.ReindexSource
+	jobs          *jobs
+	adminServer   *grpc.Server
+
+	// writeMtx guards writing the store against the block being indexed
+	writeMtx *sync.Mutex
+	// inBlock is true from FinalizeBlock until the block is written on Commit
+	inBlock bool
+	// blockDone is signaled on writeMtx when the block being indexed is written or discarded
+	blockDone *sync.Cond
+
+	// latestHeight is the latest height of the chain seen by the indexer
+	latestHeight *atomic.Int64
+	// committedHeight is the latest height committed by the indexer, persisted in committedHeightItem
+	committedHeight     *atomic.Int64
+	committedHeightItem collections.Item[int64]
+	// indexedHeights is the latest height successfully indexed by each submodule
+	indexedHeights map[string]*atomic.Int64
+	// failedHeights is the latest height each submodule failed to index
+	failedHeights map[string]*atomic.Int64
 }
 
-// Close closes indexer goleveldb
+// Close stops the admin service and closes indexer goleveldb
 func (k Keeper) Close() error {
+	if k.adminServer != nil {
+		k.adminServer.Stop()
+	}
+
 	if k.db != nil {
 		return k.db.Close()
 	}
@@ -63,22 +92,37 @@ func NewKeeper(
 ) *Keeper {
 
 	k := &Keeper{
-		cdc:            cdc,
-		vmType:         vmType,
-		db:             db,
-		config:         config,
-		schema:         nil,
-		ac:             ac,
-		vc:             vc,
-		sealed:         false,
-		pruningRunning: &atomic.Bool{},
+		cdc:              cdc,
+		vmType:           vmType,
+		db:               db,
+		config:           config,
+		schema:           nil,
+		ac:               ac,
+		vc:               vc,
+		sealed:           false,
+		pruningRunning:   &atomic.Bool{},
+		reconcileRunning: &atomic.Bool{},
+		paused:           &atomic.Bool{},
+		jobs:             newJobs(),
+		latestHeight:     &atomic.Int64{},
+		committedHeight:  &atomic.Int64{},
+		indexedHeights:   map[string]*atomic.Int64{},
+		failedHeights:    map[string]*atomic.Int64{},
 	}
 
+	k.writeMtx = &sync.Mutex{}
+	k.blockDone = sync.NewCond(k.writeMtx)
+
 	sb := collections.NewSchemaBuilderFromAccessor(
 		func(ctx context.Context) corestoretypes.KVStore {
+			// pruning counts the keys it deletes
+			if deleted, ok := ctx.Value(deletedKeysKey{}).(*atomic.Uint64); ok {
+				return deleteCountingStore{KVStore: k.store, deleted: deleted}
+			}
 			return k.store
 		})
 	k.schemaBuilder = sb
+	k.committedHeightItem = collections.NewItem(sb, types.CommittedHeightPrefix, "committed_height", collections.Int64Value)
 
 	return k
 }
@@ -144,3 +188,52 @@ func (k Keeper) GetAddressCodec() address.Codec {
 func (k Keeper) GetValidatorAddressCodec() address.Codec {
 	return k.vc
 }
+
+// SetReindexSource sets the source of the blocks and states used by the reindex and reconcile jobs.
+// Without it, the reindex and reconcile jobs can't start. See indexer.NewReindexSource.
+func (k *Keeper) SetReindexSource(source types.ReindexSource) {
+	k.reindexSource = source
+}
+
+// IsPaused returns whether indexing is paused by the admin service.
+func (k Keeper) IsPaused() bool {
+	return k.paused.Load()
+}
+
+// beginBlock marks the block is being indexed, so that its partial state isn't flushed.
+func (k *Keeper) beginBlock() {
+	k.writeMtx.Lock()
+	defer k.writeMtx.Unlock()
+
+	k.inBlock = true
+}
+
+// commitBlock writes the indexed block to the db.
+func (k *Keeper) commitBlock() {
+	k.writeMtx.Lock()
+	defer k.writeMtx.Unlock()
+
+	k.store.Write()
+	k.inBlock = false
+	k.blockDone.Broadcast()
+}
+
+// abortBlock discards the block being indexed, so that its partial state isn't written by the next commit or flush.
+func (k *Keeper) abortBlock() {
+	k.writeMtx.Lock()
+	defer k.writeMtx.Unlock()
+
+	k.store.Discard()
+	k.inBlock = false
+	k.blockDone.Broadcast()
+}
+
+// flush writes the cached store to the db. If a block is being indexed, it's left to the commit of the block.
+func (k *Keeper) flush() {
+	k.writeMtx.Lock()
+	defer k.writeMtx.Unlock()
+
+	if !k.inBlock {
+		k.store.Write()
+	}
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"sync/atomic"
+	"time"
+
+	corestoretypes "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+const (
+	metricKeyFinalizeBlock = "finalize_block"
+	metricKeyCommit        = "commit"
+	metricKeyPrune         = "prune"
+	metricKeyError         = "error"
+	metricKeyPanic         = "panic"
+	metricKeyDeletedKeys   = "deleted_keys"
+	metricKeyHeight        = "height"
+	metricKeyLag           = "lag"
+	metricKeyStoreSize     = "store_size"
+	metricKeyCache         = "cache"
+	metricKeyHit           = "hit"
+	metricKeyMiss          = "miss"
+
+	metricLabelSubmodule = "submodule"
+
+	// storeSizeInterval is the block interval to report the size of the store, as reading it walks the levels of the db
+	storeSizeInterval = 100
+)
+
+// deletedKeysKey is the context key of the counter of the keys deleted by pruning.
+type deletedKeysKey struct{}
+
+// deleteCountingStore counts the keys deleted through it.
+type deleteCountingStore struct {
+	corestoretypes.KVStore
+
+	deleted *atomic.Uint64
+}
+
+func (s deleteCountingStore) Delete(key []byte) error {
+	if err := s.KVStore.Delete(key); err != nil {
+		return err
+	}
+	s.deleted.Add(1)
+	return nil
+}
+
+// measureSubmodule emits the duration of the submodule's event handling.
+func measureSubmodule(name string, start time.Time, key string) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.MeasureSinceWithLabels([]string{types.ModuleName, key}, start.UTC(), []metrics.Label{telemetry.NewLabel(metricLabelSubmodule, name)})
+}
+
+// incrSubmoduleCounter increases the counter of the submodule's event handling, e.g. errors and panics.
+func incrSubmoduleCounter(name string, keys ...string) {
+	telemetry.IncrCounterWithLabels(append([]string{types.ModuleName}, keys...), 1, []metrics.Label{telemetry.NewLabel(metricLabelSubmodule, name)})
+}
+
+// setSubmoduleGauge sets the gauge of the submodule.
+func setSubmoduleGauge(name string, val float32, keys ...string) {
+	telemetry.SetGaugeWithLabels(append([]string{types.ModuleName}, keys...), val, []metrics.Label{telemetry.NewLabel(metricLabelSubmodule, name)})
+}
+
+// setCacheCounters emits the cache hits and misses since the last commit.
+func (k *Keeper) setCacheCounters() {
+	hits, misses := k.store.CacheStats()
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	telemetry.IncrCounter(float32(hits), types.ModuleName, metricKeyCache, metricKeyHit)
+	telemetry.IncrCounter(float32(misses), types.ModuleName, metricKeyCache, metricKeyMiss)
+}
+
+// setLagGauges sets the number of blocks each submodule is behind the given height.
+func (k *Keeper) setLagGauges(height int64) {
+	for _, svc := range k.submodules {
+		setSubmoduleGauge(svc.Name(), float32(height-k.indexedHeights[svc.Name()].Load()), metricKeyLag)
+	}
+}
+
+// setStoreSizeGauge sets the size of the store on disk every storeSizeInterval blocks if the backend reports it.
+func (k *Keeper) setStoreSizeGauge(height int64) {
+	if !telemetry.IsTelemetryEnabled() || height%storeSizeInterval != 0 {
+		return
+	}
+
+	ldb, ok := k.db.(interface{ DB() *leveldb.DB })
+	if !ok {
+		return
+	}
+
+	stats := leveldb.DBStats{}
+	if err := ldb.DB().Stats(&stats); err != nil {
+		return
+	}
+
+	telemetry.SetGauge(float32(stats.LevelSizes.Sum()), types.ModuleName, metricKeyStoreSize)
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+var errReconcileRunning = errors.New("reconciliation is already running")
+
+// Reconcile fixes the indices of the submodules implementing types.Reconciler with the VM state of the height
+// each submodule is indexed up to. If name is empty, all the registered submodules are reconciled.
+func (k *Keeper) Reconcile(name string) ([]types.SubmoduleReconciliation, error) {
+	if running := k.reconcileRunning.Swap(true); running {
+		return nil, errReconcileRunning
+	}
+	defer k.reconcileRunning.Store(false)
+
+	results := []types.SubmoduleReconciliation{}
+	for _, svc := range k.submodules {
+		if name != "" && svc.Name() != name {
+			continue
+		}
+
+		result := types.SubmoduleReconciliation{
+			Submodule:   svc.Name(),
+			Corrections: []types.Correction{},
+			Failures:    []types.ReconcileFailure{},
+		}
+
+		reconciler, ok := svc.(types.Reconciler)
+		if ok {
+			corrections, failures, err := k.reconcileSubmodule(svc.Name(), reconciler)
+			if err != nil {
+				return nil, fmt.Errorf("failed to reconcile submodule %s: %w", svc.Name(), err)
+			}
+			result.Reconciled = true
+			result.Corrections = append(result.Corrections, corrections...)
+			result.Failures = append(result.Failures, failures...)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// reconcileSubmodule reconciles the submodule holding the write lock between the blocks, so that its indices
+// are at the height it's indexed up to, which the VM state is read at, and the blocks don't interleave with
+// the corrections. The next block waits for the reconciliation.
+func (k *Keeper) reconcileSubmodule(name string, reconciler types.Reconciler) ([]types.Correction, []types.ReconcileFailure, error) {
+	k.writeMtx.Lock()
+	defer k.writeMtx.Unlock()
+
+	for k.inBlock {
+		k.blockDone.Wait()
+	}
+
+	height := k.indexedHeights[name].Load()
+	if height == 0 {
+		return nil, nil, nil
+	}
+
+	ctx, err := k.reindexSource.QueryContext(height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get context of height %d: %w", height, err)
+	}
+
+	corrections, failures, err := reconciler.Reconcile(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, c := range corrections {
+		k.Logger(ctx).Info("reconciled", "submodule", name, "height", height, "target", c.Target, "field", c.Field, "old", c.OldValue, "new", c.NewValue)
+	}
+	for _, f := range failures {
+		k.Logger(ctx).Warn("failed to reconcile", "submodule", name, "height", height, "target", f.Target, "error", f.Error)
+	}
+
+	return corrections, failures, nil
+}
+
+// startReconcile starts a job reconciling the submodules with the VM state.
+// If name is empty, all the registered submodules are reconciled.
+func (k *Keeper) startReconcile(name string) types.Job {
+	return k.jobs.start(types.Job{Type: jobTypeReconcile}, func(update func(func(*types.Job))) error {
+		results, err := k.Reconcile(name)
+		if err != nil {
+			return err
+		}
+
+		update(func(job *types.Job) {
+			job.Reconciliations = results
+		})
+		return nil
+	})
+}
+
+// scheduleReconcile starts the periodic reconcile job unless the previous one is still running.
+func (k *Keeper) scheduleReconcile(ctx context.Context) {
+	if k.reindexSource == nil {
+		k.Logger(ctx).Warn("reindex source is not set, skipping reconciliation")
+		return
+	}
+	if k.jobs.isRunning(jobTypeReconcile) {
+		return
+	}
+
+	k.startReconcile("")
+}
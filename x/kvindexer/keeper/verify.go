@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+// Verify checks the indices of the submodules implementing types.Verifier.
+// If name is empty, all the registered submodules are verified.
+func (k *Keeper) Verify(ctx context.Context, name string) ([]types.SubmoduleVerification, error) {
+	results := []types.SubmoduleVerification{}
+	for _, svc := range k.submodules {
+		if name != "" && svc.Name() != name {
+			continue
+		}
+
+		result := types.SubmoduleVerification{
+			Submodule:  svc.Name(),
+			Violations: []types.InvariantViolation{},
+		}
+
+		verifier, ok := svc.(types.Verifier)
+		if ok {
+			violations, err := verifier.Verify(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to verify submodule %s: %w", svc.Name(), err)
+			}
+			result.Verified = true
+			result.Violations = append(result.Violations, violations...)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (k *Keeper) hasSubmodule(name string) bool {
+	for _, svc := range k.submodules {
+		if svc.Name() == name {
+			return true
+		}
+	}
+	return false
+}
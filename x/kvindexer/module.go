@@ -29,6 +29,7 @@ func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, serv
 	if err != nil {
 		panic(err)
 	}
+	registerReadyHandler(clientCtx, serveMux)
 
 	submodules := b.keeper.GetSubmodules()
 	for _, sm := range submodules {
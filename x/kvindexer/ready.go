@@ -0,0 +1,39 @@
+package kvindexer
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/initia-labs/kvindexer/x/kvindexer/types"
+)
+
+var patternReady = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"indexer", "ready"}, "", runtime.AssumeColonVerbOpt(false)))
+
+// registerReadyHandler registers a plain HTTP readiness handler for load balancers.
+// It responds 200 if the indexer is ready, otherwise 503 with the reasons.
+func registerReadyHandler(clientCtx client.Context, mux *runtime.ServeMux) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	mux.Handle(http.MethodGet, patternReady, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+		res, err := queryClient.Health(r.Context(), &types.QueryHealthRequest{})
+		if err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(err.Error() + "\n"))
+			return
+		}
+
+		if !res.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(strings.Join(res.Reasons, "\n") + "\n"))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok\n"))
+	})
+}
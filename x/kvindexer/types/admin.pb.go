@@ -0,0 +1,3383 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: indexer/info/admin.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// JobState defines the state of the job
+type JobState int32
+
+const (
+	JobStateUnspecified JobState = 0
+	JobStateRunning     JobState = 1
+	JobStateSucceeded   JobState = 2
+	JobStateFailed      JobState = 3
+)
+
+var JobState_name = map[int32]string{
+	0: "JOB_STATE_UNSPECIFIED",
+	1: "JOB_STATE_RUNNING",
+	2: "JOB_STATE_SUCCEEDED",
+	3: "JOB_STATE_FAILED",
+}
+
+var JobState_value = map[string]int32{
+	"JOB_STATE_UNSPECIFIED": 0,
+	"JOB_STATE_RUNNING":     1,
+	"JOB_STATE_SUCCEEDED":   2,
+	"JOB_STATE_FAILED":      3,
+}
+
+func (x JobState) String() string {
+	return proto.EnumName(JobState_name, int32(x))
+}
+
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{0}
+}
+
+// AdminPruneRequest is the request type for the Admin/Prune RPC method
+type AdminPruneRequest struct {
+	// height is the minimum height to retain. The data below it is removed. It
+	// must not be greater than the committed height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *AdminPruneRequest) Reset()         { *m = AdminPruneRequest{} }
+func (m *AdminPruneRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminPruneRequest) ProtoMessage()    {}
+func (*AdminPruneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{0}
+}
+func (m *AdminPruneRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminPruneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminPruneRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminPruneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminPruneRequest.Merge(m, src)
+}
+func (m *AdminPruneRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminPruneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminPruneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminPruneRequest proto.InternalMessageInfo
+
+// AdminPruneResponse is the response type for the Admin/Prune RPC method
+type AdminPruneResponse struct {
+}
+
+func (m *AdminPruneResponse) Reset()         { *m = AdminPruneResponse{} }
+func (m *AdminPruneResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminPruneResponse) ProtoMessage()    {}
+func (*AdminPruneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{1}
+}
+func (m *AdminPruneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminPruneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminPruneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminPruneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminPruneResponse.Merge(m, src)
+}
+func (m *AdminPruneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminPruneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminPruneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminPruneResponse proto.InternalMessageInfo
+
+// AdminPauseRequest is the request type for the Admin/Pause RPC method
+type AdminPauseRequest struct {
+}
+
+func (m *AdminPauseRequest) Reset()         { *m = AdminPauseRequest{} }
+func (m *AdminPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminPauseRequest) ProtoMessage()    {}
+func (*AdminPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{2}
+}
+func (m *AdminPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminPauseRequest.Merge(m, src)
+}
+func (m *AdminPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminPauseRequest proto.InternalMessageInfo
+
+// AdminPauseResponse is the response type for the Admin/Pause RPC method
+type AdminPauseResponse struct {
+}
+
+func (m *AdminPauseResponse) Reset()         { *m = AdminPauseResponse{} }
+func (m *AdminPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminPauseResponse) ProtoMessage()    {}
+func (*AdminPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{3}
+}
+func (m *AdminPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminPauseResponse.Merge(m, src)
+}
+func (m *AdminPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminPauseResponse proto.InternalMessageInfo
+
+// AdminResumeRequest is the request type for the Admin/Resume RPC method
+type AdminResumeRequest struct {
+}
+
+func (m *AdminResumeRequest) Reset()         { *m = AdminResumeRequest{} }
+func (m *AdminResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminResumeRequest) ProtoMessage()    {}
+func (*AdminResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{4}
+}
+func (m *AdminResumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminResumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminResumeRequest.Merge(m, src)
+}
+func (m *AdminResumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminResumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminResumeRequest proto.InternalMessageInfo
+
+// AdminResumeResponse is the response type for the Admin/Resume RPC method
+type AdminResumeResponse struct {
+}
+
+func (m *AdminResumeResponse) Reset()         { *m = AdminResumeResponse{} }
+func (m *AdminResumeResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminResumeResponse) ProtoMessage()    {}
+func (*AdminResumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{5}
+}
+func (m *AdminResumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminResumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminResumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminResumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminResumeResponse.Merge(m, src)
+}
+func (m *AdminResumeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminResumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminResumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminResumeResponse proto.InternalMessageInfo
+
+// AdminFlushRequest is the request type for the Admin/Flush RPC method. If a
+// block is being indexed, the cached store is written on its commit instead.
+type AdminFlushRequest struct {
+	// compact compacts the database after flushing
+	Compact bool `protobuf:"varint,1,opt,name=compact,proto3" json:"compact,omitempty"`
+}
+
+func (m *AdminFlushRequest) Reset()         { *m = AdminFlushRequest{} }
+func (m *AdminFlushRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminFlushRequest) ProtoMessage()    {}
+func (*AdminFlushRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{6}
+}
+func (m *AdminFlushRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminFlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminFlushRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminFlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminFlushRequest.Merge(m, src)
+}
+func (m *AdminFlushRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminFlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminFlushRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminFlushRequest proto.InternalMessageInfo
+
+// AdminFlushResponse is the response type for the Admin/Flush RPC method
+type AdminFlushResponse struct {
+}
+
+func (m *AdminFlushResponse) Reset()         { *m = AdminFlushResponse{} }
+func (m *AdminFlushResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminFlushResponse) ProtoMessage()    {}
+func (*AdminFlushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{7}
+}
+func (m *AdminFlushResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminFlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminFlushResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminFlushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminFlushResponse.Merge(m, src)
+}
+func (m *AdminFlushResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminFlushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminFlushResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminFlushResponse proto.InternalMessageInfo
+
+// AdminStartReindexRequest is the request type for the Admin/StartReindex RPC
+// method. The indexer must be paused while reindexing, and the heights must be
+// above the indexed height of the submodules as not every index is idempotent.
+// It replays the blocks skipped while paused.
+type AdminStartReindexRequest struct {
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// submodules are the names of the submodules to reindex. If empty, all the
+	// submodules are reindexed.
+	Submodules []string `protobuf:"bytes,3,rep,name=submodules,proto3" json:"submodules,omitempty"`
+}
+
+func (m *AdminStartReindexRequest) Reset()         { *m = AdminStartReindexRequest{} }
+func (m *AdminStartReindexRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminStartReindexRequest) ProtoMessage()    {}
+func (*AdminStartReindexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{8}
+}
+func (m *AdminStartReindexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminStartReindexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminStartReindexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminStartReindexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminStartReindexRequest.Merge(m, src)
+}
+func (m *AdminStartReindexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminStartReindexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminStartReindexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminStartReindexRequest proto.InternalMessageInfo
+
+// AdminStartReconcileRequest is the request type for the Admin/StartReconcile
+// RPC method
+type AdminStartReconcileRequest struct {
+	Submodule string `protobuf:"bytes,1,opt,name=submodule,proto3" json:"submodule,omitempty"`
+}
+
+func (m *AdminStartReconcileRequest) Reset()         { *m = AdminStartReconcileRequest{} }
+func (m *AdminStartReconcileRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminStartReconcileRequest) ProtoMessage()    {}
+func (*AdminStartReconcileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{9}
+}
+func (m *AdminStartReconcileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminStartReconcileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminStartReconcileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminStartReconcileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminStartReconcileRequest.Merge(m, src)
+}
+func (m *AdminStartReconcileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminStartReconcileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminStartReconcileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminStartReconcileRequest proto.InternalMessageInfo
+
+// AdminStartVerifyRequest is the request type for the Admin/StartVerify RPC
+// method
+type AdminStartVerifyRequest struct {
+	Submodule string `protobuf:"bytes,1,opt,name=submodule,proto3" json:"submodule,omitempty"`
+}
+
+func (m *AdminStartVerifyRequest) Reset()         { *m = AdminStartVerifyRequest{} }
+func (m *AdminStartVerifyRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminStartVerifyRequest) ProtoMessage()    {}
+func (*AdminStartVerifyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{10}
+}
+func (m *AdminStartVerifyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminStartVerifyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminStartVerifyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminStartVerifyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminStartVerifyRequest.Merge(m, src)
+}
+func (m *AdminStartVerifyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminStartVerifyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminStartVerifyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminStartVerifyRequest proto.InternalMessageInfo
+
+// AdminJobRequest is the request type for the Admin/Job RPC method
+type AdminJobRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *AdminJobRequest) Reset()         { *m = AdminJobRequest{} }
+func (m *AdminJobRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminJobRequest) ProtoMessage()    {}
+func (*AdminJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{11}
+}
+func (m *AdminJobRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminJobRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminJobRequest.Merge(m, src)
+}
+func (m *AdminJobRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminJobRequest proto.InternalMessageInfo
+
+// AdminJobResponse is the response type for the Admin/StartXXX and Admin/Job
+// RPC methods
+type AdminJobResponse struct {
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+}
+
+func (m *AdminJobResponse) Reset()         { *m = AdminJobResponse{} }
+func (m *AdminJobResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminJobResponse) ProtoMessage()    {}
+func (*AdminJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{12}
+}
+func (m *AdminJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminJobResponse.Merge(m, src)
+}
+func (m *AdminJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminJobResponse proto.InternalMessageInfo
+
+// AdminJobsRequest is the request type for the Admin/Jobs RPC method
+type AdminJobsRequest struct {
+}
+
+func (m *AdminJobsRequest) Reset()         { *m = AdminJobsRequest{} }
+func (m *AdminJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminJobsRequest) ProtoMessage()    {}
+func (*AdminJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{13}
+}
+func (m *AdminJobsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminJobsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminJobsRequest.Merge(m, src)
+}
+func (m *AdminJobsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminJobsRequest proto.InternalMessageInfo
+
+// AdminJobsResponse is the response type for the Admin/Jobs RPC method
+type AdminJobsResponse struct {
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (m *AdminJobsResponse) Reset()         { *m = AdminJobsResponse{} }
+func (m *AdminJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminJobsResponse) ProtoMessage()    {}
+func (*AdminJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{14}
+}
+func (m *AdminJobsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminJobsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminJobsResponse.Merge(m, src)
+}
+func (m *AdminJobsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminJobsResponse proto.InternalMessageInfo
+
+// Job defines a background job started by the admin service
+type Job struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is one of "reindex", "reconcile" and "verify"
+	Type       string     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	State      JobState   `protobuf:"varint,3,opt,name=state,proto3,enum=indexer.info.JobState" json:"state,omitempty"`
+	Error      string     `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt  time.Time  `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at"`
+	FinishedAt *time.Time `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3,stdtime" json:"finished_at,omitempty"`
+	// from_height, to_height and current_height are set for the reindex job
+	FromHeight    int64 `protobuf:"varint,7,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight      int64 `protobuf:"varint,8,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	CurrentHeight int64 `protobuf:"varint,9,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// verifications are set for the verify job
+	Verifications []SubmoduleVerification `protobuf:"bytes,10,rep,name=verifications,proto3" json:"verifications"`
+	// reconciliations are set for the reconcile job
+	Reconciliations []SubmoduleReconciliation `protobuf:"bytes,11,rep,name=reconciliations,proto3" json:"reconciliations"`
+}
+
+func (m *Job) Reset()         { *m = Job{} }
+func (m *Job) String() string { return proto.CompactTextString(m) }
+func (*Job) ProtoMessage()    {}
+func (*Job) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04b4789b1402f11b, []int{15}
+}
+func (m *Job) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Job) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Job.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Job) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Job.Merge(m, src)
+}
+func (m *Job) XXX_Size() int {
+	return m.Size()
+}
+func (m *Job) XXX_DiscardUnknown() {
+	xxx_messageInfo_Job.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Job proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("indexer.info.JobState", JobState_name, JobState_value)
+	proto.RegisterType((*AdminPruneRequest)(nil), "indexer.info.AdminPruneRequest")
+	proto.RegisterType((*AdminPruneResponse)(nil), "indexer.info.AdminPruneResponse")
+	proto.RegisterType((*AdminPauseRequest)(nil), "indexer.info.AdminPauseRequest")
+	proto.RegisterType((*AdminPauseResponse)(nil), "indexer.info.AdminPauseResponse")
+	proto.RegisterType((*AdminResumeRequest)(nil), "indexer.info.AdminResumeRequest")
+	proto.RegisterType((*AdminResumeResponse)(nil), "indexer.info.AdminResumeResponse")
+	proto.RegisterType((*AdminFlushRequest)(nil), "indexer.info.AdminFlushRequest")
+	proto.RegisterType((*AdminFlushResponse)(nil), "indexer.info.AdminFlushResponse")
+	proto.RegisterType((*AdminStartReindexRequest)(nil), "indexer.info.AdminStartReindexRequest")
+	proto.RegisterType((*AdminStartReconcileRequest)(nil), "indexer.info.AdminStartReconcileRequest")
+	proto.RegisterType((*AdminStartVerifyRequest)(nil), "indexer.info.AdminStartVerifyRequest")
+	proto.RegisterType((*AdminJobRequest)(nil), "indexer.info.AdminJobRequest")
+	proto.RegisterType((*AdminJobResponse)(nil), "indexer.info.AdminJobResponse")
+	proto.RegisterType((*AdminJobsRequest)(nil), "indexer.info.AdminJobsRequest")
+	proto.RegisterType((*AdminJobsResponse)(nil), "indexer.info.AdminJobsResponse")
+	proto.RegisterType((*Job)(nil), "indexer.info.Job")
+}
+
+func init() { proto.RegisterFile("indexer/info/admin.proto", fileDescriptor_04b4789b1402f11b) }
+
+var fileDescriptor_04b4789b1402f11b = []byte{
+	// 925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xcf, 0x73, 0xda, 0x46,
+	0x14, 0xc7, 0x91, 0x01, 0x07, 0x1e, 0x09, 0x86, 0xc5, 0x4e, 0x34, 0x6a, 0x2b, 0x14, 0x32, 0xee,
+	0x30, 0x69, 0x03, 0x33, 0xf4, 0x90, 0x99, 0xdc, 0xb0, 0xc1, 0xa9, 0x3d, 0xad, 0xe3, 0x11, 0xc6,
+	0x87, 0x5c, 0x3c, 0x42, 0x5a, 0x60, 0x53, 0xd0, 0x52, 0x69, 0x95, 0x71, 0xae, 0x3d, 0x75, 0x7c,
+	0xca, 0x3f, 0xe0, 0x53, 0xff, 0x19, 0x4f, 0x4f, 0x99, 0xe9, 0xa5, 0xa7, 0xfe, 0xb0, 0xff, 0x91,
+	0x8c, 0x56, 0xbb, 0x20, 0xd9, 0x10, 0xfb, 0xa6, 0x7d, 0xef, 0xf3, 0xbe, 0x5a, 0xbd, 0x7d, 0xfb,
+	0x1d, 0x81, 0x4a, 0x5c, 0x07, 0x9f, 0x61, 0xaf, 0x49, 0xdc, 0x21, 0x6d, 0x5a, 0xce, 0x94, 0xb8,
+	0x8d, 0x99, 0x47, 0x19, 0x45, 0x0f, 0x45, 0xa6, 0x11, 0x66, 0xb4, 0xcd, 0x11, 0x1d, 0x51, 0x9e,
+	0x68, 0x86, 0x4f, 0x11, 0xa3, 0x55, 0x47, 0x94, 0x8e, 0x26, 0xb8, 0xc9, 0x57, 0x83, 0x60, 0xd8,
+	0x64, 0x64, 0x8a, 0x7d, 0x66, 0x4d, 0x67, 0x02, 0x48, 0xca, 0xb3, 0x0f, 0x33, 0xec, 0x47, 0x99,
+	0xda, 0x77, 0x50, 0x6e, 0x87, 0x6f, 0x3b, 0xf2, 0x02, 0x17, 0x9b, 0xf8, 0xd7, 0x00, 0xfb, 0x0c,
+	0x3d, 0x86, 0xf5, 0x31, 0x26, 0xa3, 0x31, 0x53, 0x15, 0x43, 0xa9, 0xa7, 0x4d, 0xb1, 0xaa, 0x6d,
+	0x02, 0x8a, 0xc3, 0xfe, 0x8c, 0xba, 0x3e, 0xae, 0x55, 0xa4, 0x84, 0x15, 0xf8, 0x52, 0x62, 0x81,
+	0x46, 0x41, 0x81, 0xca, 0xa8, 0x89, 0xfd, 0x60, 0x3a, 0x67, 0xb7, 0xa0, 0x92, 0x88, 0x0a, 0xf8,
+	0x85, 0xd0, 0xdd, 0x9b, 0x04, 0xfe, 0x58, 0x6e, 0x4d, 0x85, 0x07, 0x36, 0x9d, 0xce, 0x2c, 0x3b,
+	0xda, 0x5b, 0xce, 0x94, 0xcb, 0xb9, 0xb6, 0xc0, 0x85, 0xc8, 0x19, 0xa8, 0x3c, 0xda, 0x63, 0x96,
+	0xc7, 0x4c, 0xcc, 0xfb, 0x20, 0xb5, 0xaa, 0x50, 0x18, 0x7a, 0x74, 0x7a, 0x9a, 0xf8, 0x56, 0x08,
+	0x43, 0x3f, 0xf2, 0x08, 0xfa, 0x0a, 0xf2, 0x8c, 0xca, 0xf4, 0x1a, 0x4f, 0xe7, 0x18, 0x15, 0x49,
+	0x1d, 0xc0, 0x0f, 0x06, 0x53, 0xea, 0x04, 0x13, 0xec, 0xab, 0x69, 0x23, 0x5d, 0xcf, 0x9b, 0xb1,
+	0x48, 0xed, 0x15, 0x68, 0xf1, 0x37, 0xdb, 0xd4, 0xb5, 0xc9, 0x64, 0xde, 0xe2, 0xaf, 0x21, 0x3f,
+	0x67, 0xf9, 0x9b, 0xf3, 0xe6, 0x22, 0x50, 0x7b, 0x09, 0x4f, 0x16, 0xb5, 0x27, 0xd8, 0x23, 0xc3,
+	0x0f, 0xf7, 0x2b, 0x7c, 0x0a, 0x1b, 0xbc, 0xf0, 0x80, 0x0e, 0x64, 0x41, 0x11, 0xd6, 0x88, 0xc3,
+	0xc9, 0x8c, 0xb9, 0x46, 0x9c, 0xda, 0x4b, 0x28, 0x2d, 0x90, 0xa8, 0x4b, 0xe8, 0x19, 0xa4, 0xdf,
+	0xd1, 0x01, 0x87, 0x0a, 0xad, 0x72, 0x23, 0x3e, 0x72, 0x8d, 0x90, 0x0b, 0xb3, 0x35, 0xb4, 0x28,
+	0xf4, 0xe5, 0xd1, 0xbd, 0x82, 0x72, 0x2c, 0x26, 0xd4, 0xb6, 0x21, 0xf3, 0x8e, 0x0e, 0x7c, 0x55,
+	0x31, 0xd2, 0xcb, 0xe5, 0x78, 0xba, 0xf6, 0x5b, 0x06, 0xd2, 0x07, 0x74, 0x70, 0x73, 0x83, 0x08,
+	0x41, 0x26, 0x9c, 0x50, 0xde, 0xf0, 0xbc, 0xc9, 0x9f, 0xd1, 0xf7, 0x90, 0xf5, 0x99, 0xc5, 0xb0,
+	0x9a, 0x36, 0x94, 0x7a, 0xb1, 0xf5, 0xf8, 0x96, 0x66, 0x2f, 0xcc, 0x9a, 0x11, 0x84, 0x36, 0x21,
+	0x8b, 0x3d, 0x8f, 0x7a, 0x6a, 0x86, 0x4b, 0x44, 0x0b, 0xb4, 0x0b, 0xe0, 0x87, 0xfd, 0xc4, 0xce,
+	0xa9, 0xc5, 0xd4, 0x2c, 0xff, 0x56, 0xad, 0x11, 0x5d, 0x9d, 0x86, 0xbc, 0x3a, 0x8d, 0x63, 0x79,
+	0x75, 0x76, 0x72, 0x97, 0xff, 0x54, 0x53, 0x1f, 0xff, 0xad, 0x2a, 0x66, 0x5e, 0xd4, 0xb5, 0x19,
+	0x6a, 0x43, 0x61, 0x48, 0x5c, 0xe2, 0x8f, 0x23, 0x95, 0xf5, 0x3b, 0x55, 0x32, 0x5c, 0x01, 0x64,
+	0x51, 0xfb, 0xd6, 0xd8, 0x3d, 0xf8, 0xf2, 0xd8, 0xe5, 0x6e, 0x8c, 0xdd, 0x36, 0x14, 0xed, 0xc0,
+	0xf3, 0xb0, 0xcb, 0x24, 0x91, 0xe7, 0xc4, 0x23, 0x11, 0x15, 0xd8, 0x1b, 0x78, 0xf4, 0x3e, 0x9c,
+	0x1b, 0x62, 0x5b, 0x8c, 0x50, 0xd7, 0x57, 0x81, 0x1f, 0xc6, 0xb3, 0x64, 0xe3, 0x7a, 0x72, 0x70,
+	0x4e, 0x62, 0xec, 0x4e, 0x26, 0xfc, 0x70, 0x33, 0x59, 0x8f, 0xfa, 0xb0, 0xe1, 0x89, 0x21, 0x26,
+	0x42, 0xb2, 0xc0, 0x25, 0xb7, 0x57, 0x48, 0x9a, 0x09, 0x5a, 0x88, 0xde, 0xd4, 0x78, 0xfe, 0xa7,
+	0x02, 0x39, 0x79, 0x7c, 0xa8, 0x05, 0x5b, 0x07, 0x6f, 0x76, 0x4e, 0x7b, 0xc7, 0xed, 0xe3, 0xee,
+	0x69, 0xff, 0xb0, 0x77, 0xd4, 0xdd, 0xdd, 0xdf, 0xdb, 0xef, 0x76, 0x4a, 0x29, 0xed, 0xc9, 0xf9,
+	0x85, 0x51, 0x91, 0x60, 0xdf, 0xf5, 0x67, 0xd8, 0x26, 0x43, 0x82, 0x1d, 0xf4, 0x1c, 0xca, 0x8b,
+	0x1a, 0xb3, 0x7f, 0x78, 0xb8, 0x7f, 0xf8, 0xba, 0xa4, 0x68, 0x95, 0xf3, 0x0b, 0x63, 0x63, 0x3e,
+	0x17, 0x81, 0xeb, 0x12, 0x77, 0x84, 0x1a, 0x50, 0x59, 0xb0, 0xbd, 0xfe, 0xee, 0x6e, 0xb7, 0xdb,
+	0xe9, 0x76, 0x4a, 0x6b, 0xda, 0xd6, 0xf9, 0x85, 0x51, 0x96, 0x74, 0x2f, 0xb0, 0x6d, 0x8c, 0x1d,
+	0xec, 0xa0, 0x3a, 0x94, 0x16, 0xfc, 0x5e, 0x7b, 0xff, 0xa7, 0x6e, 0xa7, 0x94, 0xd6, 0xd0, 0xf9,
+	0x85, 0x51, 0x94, 0xf0, 0x9e, 0x45, 0x26, 0xd8, 0xd1, 0x32, 0xbf, 0xff, 0xa1, 0xa7, 0x5a, 0x7f,
+	0x65, 0x21, 0xcb, 0xaf, 0x03, 0x3a, 0x80, 0x2c, 0x37, 0x49, 0x54, 0x4d, 0x76, 0xe7, 0x96, 0xd7,
+	0x6a, 0xc6, 0x6a, 0x40, 0x5c, 0xa7, 0x50, 0x2b, 0x74, 0xd1, 0xe5, 0x5a, 0x31, 0xd3, 0xd5, 0x8c,
+	0xd5, 0x80, 0xd0, 0xfa, 0x19, 0xd6, 0x23, 0x97, 0x45, 0xcb, 0xd8, 0x84, 0x2d, 0x6b, 0x4f, 0xbf,
+	0x40, 0x2c, 0xb6, 0xc6, 0xed, 0x76, 0xe9, 0xd6, 0xe2, 0xbe, 0xad, 0x19, 0xab, 0x01, 0xa1, 0x75,
+	0x02, 0x0f, 0xe3, 0x26, 0x8d, 0xbe, 0x5d, 0x52, 0xb1, 0xc4, 0xc5, 0x35, 0x7d, 0x09, 0x17, 0xf7,
+	0xb6, 0xb7, 0x50, 0x4c, 0x5a, 0x30, 0xaa, 0xaf, 0x56, 0x4e, 0xba, 0xf4, 0x9d, 0xda, 0xc7, 0x50,
+	0x88, 0x59, 0x34, 0xda, 0x5e, 0x25, 0x9c, 0xb0, 0xf0, 0x3b, 0x55, 0x3b, 0x91, 0x2f, 0x7e, 0xb3,
+	0x0a, 0xbb, 0x9f, 0xca, 0x6b, 0xc8, 0x84, 0xae, 0x8c, 0x56, 0x70, 0xd2, 0xc2, 0xb5, 0xea, 0xca,
+	0x7c, 0x24, 0xb4, 0x73, 0x74, 0xf9, 0xbf, 0x9e, 0xba, 0xbc, 0xd2, 0x95, 0x4f, 0x57, 0xba, 0xf2,
+	0xdf, 0x95, 0xae, 0x7c, 0xbc, 0xd6, 0x53, 0x9f, 0xae, 0xf5, 0xd4, 0xdf, 0xd7, 0x7a, 0xea, 0x6d,
+	0x6b, 0x44, 0xd8, 0x38, 0x18, 0x34, 0x6c, 0x3a, 0x6d, 0x12, 0x97, 0x30, 0x62, 0xbd, 0x98, 0x58,
+	0x03, 0xbf, 0xf9, 0xcb, 0x7b, 0xf9, 0xcf, 0x71, 0x16, 0x7b, 0xe6, 0xbf, 0x1e, 0x83, 0x75, 0xee,
+	0x93, 0x3f, 0x7c, 0x1e, 0x00, 0x4d, 0x7d, 0x9b, 0x55, 0xf6, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	// Prune removes the indexed data below the given height
+	Prune(ctx context.Context, in *AdminPruneRequest, opts ...grpc.CallOption) (*AdminPruneResponse, error)
+	// Pause stops indexing the incoming blocks. The blocks finalized while
+	// paused are not indexed unless they are reindexed.
+	Pause(ctx context.Context, in *AdminPauseRequest, opts ...grpc.CallOption) (*AdminPauseResponse, error)
+	// Resume restarts indexing the incoming blocks
+	Resume(ctx context.Context, in *AdminResumeRequest, opts ...grpc.CallOption) (*AdminResumeResponse, error)
+	// Flush writes the cached store to the database and optionally compacts it
+	Flush(ctx context.Context, in *AdminFlushRequest, opts ...grpc.CallOption) (*AdminFlushResponse, error)
+	// StartReindex starts a job replaying the blocks in the height range
+	StartReindex(ctx context.Context, in *AdminStartReindexRequest, opts ...grpc.CallOption) (*AdminJobResponse, error)
+	// StartReconcile starts a job reconciling the submodules with the VM store
+	StartReconcile(ctx context.Context, in *AdminStartReconcileRequest, opts ...grpc.CallOption) (*AdminJobResponse, error)
+	// StartVerify starts a job verifying the indices of the submodules
+	StartVerify(ctx context.Context, in *AdminStartVerifyRequest, opts ...grpc.CallOption) (*AdminJobResponse, error)
+	// Job returns the job of the given id
+	Job(ctx context.Context, in *AdminJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error)
+	// Jobs returns all the jobs started since the node started
+	Jobs(ctx context.Context, in *AdminJobsRequest, opts ...grpc.CallOption) (*AdminJobsResponse, error)
+}
+
+type adminClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewAdminClient(cc grpc1.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) Prune(ctx context.Context, in *AdminPruneRequest, opts ...grpc.CallOption) (*AdminPruneResponse, error) {
+	out := new(AdminPruneResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Admin/Prune", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Pause(ctx context.Context, in *AdminPauseRequest, opts ...grpc.CallOption) (*AdminPauseResponse, error) {
+	out := new(AdminPauseResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Admin/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Resume(ctx context.Context, in *AdminResumeRequest, opts ...grpc.CallOption) (*AdminResumeResponse, error) {
+	out := new(AdminResumeResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Admin/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Flush(ctx context.Context, in *AdminFlushRequest, opts ...grpc.CallOption) (*AdminFlushResponse, error) {
+	out := new(AdminFlushResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Admin/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) StartReindex(ctx context.Context, in *AdminStartReindexRequest, opts ...grpc.CallOption) (*AdminJobResponse, error) {
+	out := new(AdminJobResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Admin/StartReindex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) StartReconcile(ctx context.Context, in *AdminStartReconcileRequest, opts ...grpc.CallOption) (*AdminJobResponse, error) {
+	out := new(AdminJobResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Admin/StartReconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) StartVerify(ctx context.Context, in *AdminStartVerifyRequest, opts ...grpc.CallOption) (*AdminJobResponse, error) {
+	out := new(AdminJobResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Admin/StartVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Job(ctx context.Context, in *AdminJobRequest, opts ...grpc.CallOption) (*AdminJobResponse, error) {
+	out := new(AdminJobResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Admin/Job", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Jobs(ctx context.Context, in *AdminJobsRequest, opts ...grpc.CallOption) (*AdminJobsResponse, error) {
+	out := new(AdminJobsResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Admin/Jobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	// Prune removes the indexed data below the given height
+	Prune(context.Context, *AdminPruneRequest) (*AdminPruneResponse, error)
+	// Pause stops indexing the incoming blocks. The blocks finalized while
+	// paused are not indexed unless they are reindexed.
+	Pause(context.Context, *AdminPauseRequest) (*AdminPauseResponse, error)
+	// Resume restarts indexing the incoming blocks
+	Resume(context.Context, *AdminResumeRequest) (*AdminResumeResponse, error)
+	// Flush writes the cached store to the database and optionally compacts it
+	Flush(context.Context, *AdminFlushRequest) (*AdminFlushResponse, error)
+	// StartReindex starts a job replaying the blocks in the height range
+	StartReindex(context.Context, *AdminStartReindexRequest) (*AdminJobResponse, error)
+	// StartReconcile starts a job reconciling the submodules with the VM store
+	StartReconcile(context.Context, *AdminStartReconcileRequest) (*AdminJobResponse, error)
+	// StartVerify starts a job verifying the indices of the submodules
+	StartVerify(context.Context, *AdminStartVerifyRequest) (*AdminJobResponse, error)
+	// Job returns the job of the given id
+	Job(context.Context, *AdminJobRequest) (*AdminJobResponse, error)
+	// Jobs returns all the jobs started since the node started
+	Jobs(context.Context, *AdminJobsRequest) (*AdminJobsResponse, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) Prune(ctx context.Context, req *AdminPruneRequest) (*AdminPruneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prune not implemented")
+}
+func (*UnimplementedAdminServer) Pause(ctx context.Context, req *AdminPauseRequest) (*AdminPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedAdminServer) Resume(ctx context.Context, req *AdminResumeRequest) (*AdminResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (*UnimplementedAdminServer) Flush(ctx context.Context, req *AdminFlushRequest) (*AdminFlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+}
+func (*UnimplementedAdminServer) StartReindex(ctx context.Context, req *AdminStartReindexRequest) (*AdminJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReindex not implemented")
+}
+func (*UnimplementedAdminServer) StartReconcile(ctx context.Context, req *AdminStartReconcileRequest) (*AdminJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReconcile not implemented")
+}
+func (*UnimplementedAdminServer) StartVerify(ctx context.Context, req *AdminStartVerifyRequest) (*AdminJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVerify not implemented")
+}
+func (*UnimplementedAdminServer) Job(ctx context.Context, req *AdminJobRequest) (*AdminJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Job not implemented")
+}
+func (*UnimplementedAdminServer) Jobs(ctx context.Context, req *AdminJobsRequest) (*AdminJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Jobs not implemented")
+}
+
+func RegisterAdminServer(s grpc1.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_Prune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPruneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Prune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Admin/Prune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Prune(ctx, req.(*AdminPruneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Admin/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Pause(ctx, req.(*AdminPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Admin/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Resume(ctx, req.(*AdminResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminFlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Flush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Admin/Flush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Flush(ctx, req.(*AdminFlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_StartReindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminStartReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).StartReindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Admin/StartReindex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).StartReindex(ctx, req.(*AdminStartReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_StartReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminStartReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).StartReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Admin/StartReconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).StartReconcile(ctx, req.(*AdminStartReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_StartVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminStartVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).StartVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Admin/StartVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).StartVerify(ctx, req.(*AdminStartVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Job_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Job(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Admin/Job",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Job(ctx, req.(*AdminJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Jobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Jobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Admin/Jobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Jobs(ctx, req.(*AdminJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Admin_serviceDesc = _Admin_serviceDesc
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "indexer.info.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Prune",
+			Handler:    _Admin_Prune_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Admin_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Admin_Resume_Handler,
+		},
+		{
+			MethodName: "Flush",
+			Handler:    _Admin_Flush_Handler,
+		},
+		{
+			MethodName: "StartReindex",
+			Handler:    _Admin_StartReindex_Handler,
+		},
+		{
+			MethodName: "StartReconcile",
+			Handler:    _Admin_StartReconcile_Handler,
+		},
+		{
+			MethodName: "StartVerify",
+			Handler:    _Admin_StartVerify_Handler,
+		},
+		{
+			MethodName: "Job",
+			Handler:    _Admin_Job_Handler,
+		},
+		{
+			MethodName: "Jobs",
+			Handler:    _Admin_Jobs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "indexer/info/admin.proto",
+}
+
+func (m *AdminPruneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminPruneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminPruneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminPruneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminPruneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminPruneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminResumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminResumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminResumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminResumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminResumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminFlushRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminFlushRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminFlushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compact {
+		i--
+		if m.Compact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminFlushResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminFlushResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminFlushResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminStartReindexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminStartReindexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminStartReindexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submodules) > 0 {
+		for iNdEx := len(m.Submodules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Submodules[iNdEx])
+			copy(dAtA[i:], m.Submodules[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Submodules[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminStartReconcileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminStartReconcileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminStartReconcileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submodule) > 0 {
+		i -= len(m.Submodule)
+		copy(dAtA[i:], m.Submodule)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Submodule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminStartVerifyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminStartVerifyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminStartVerifyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submodule) > 0 {
+		i -= len(m.Submodule)
+		copy(dAtA[i:], m.Submodule)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Submodule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminJobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for iNdEx := len(m.Jobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Job) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reconciliations) > 0 {
+		for iNdEx := len(m.Reconciliations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reconciliations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FinishedAt != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.FinishedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.FinishedAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAdmin(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAdmin(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.State != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AdminPruneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovAdmin(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AdminPruneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminResumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminResumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminFlushRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compact {
+		n += 2
+	}
+	return n
+}
+
+func (m *AdminFlushResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminStartReindexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovAdmin(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovAdmin(uint64(m.ToHeight))
+	}
+	if len(m.Submodules) > 0 {
+		for _, s := range m.Submodules {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AdminStartReconcileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submodule)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminStartVerifyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submodule)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdmin(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *AdminJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AdminJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AdminJobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Jobs) > 0 {
+		for _, e := range m.Jobs {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Job) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdmin(uint64(m.Id))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovAdmin(uint64(m.State))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovAdmin(uint64(l))
+	if m.FinishedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.FinishedAt)
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovAdmin(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovAdmin(uint64(m.ToHeight))
+	}
+	if m.CurrentHeight != 0 {
+		n += 1 + sovAdmin(uint64(m.CurrentHeight))
+	}
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Reconciliations) > 0 {
+		for _, e := range m.Reconciliations {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AdminPruneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminPruneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminPruneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminPruneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminPruneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminPruneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminPauseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminPauseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminResumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminResumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminResumeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminResumeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminResumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminFlushRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminFlushRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminFlushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compact", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compact = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminFlushResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminFlushResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminFlushResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminStartReindexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminStartReindexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminStartReindexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submodules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submodules = append(m.Submodules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminStartReconcileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminStartReconcileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminStartReconcileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submodule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submodule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminStartVerifyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminStartVerifyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminStartVerifyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submodule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submodule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminJobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminJobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jobs = append(m.Jobs, &Job{})
+			if err := m.Jobs[len(m.Jobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.FinishedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifications = append(m.Verifications, SubmoduleVerification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciliations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reconciliations = append(m.Reconciliations, SubmoduleReconciliation{})
+			if err := m.Reconciliations[len(m.Reconciliations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAdmin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAdmin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAdmin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAdmin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdmin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAdmin = fmt.Errorf("proto: unexpected end of group")
+)
@@ -25,3 +25,27 @@ type Submodule interface {
 	Name() string
 	Version() string
 }
+
+// Verifier is an optional interface that a submodule can implement to check the consistency of its indices.
+type Verifier interface {
+	Verify(ctx context.Context) ([]InvariantViolation, error)
+}
+
+// Reconciler is an optional interface that a submodule can implement to fix its indices with the authoritative state.
+// The entries which can't be read from the authoritative state are returned as failures instead of aborting.
+type Reconciler interface {
+	Reconcile(ctx context.Context) ([]Correction, []ReconcileFailure, error)
+}
+
+// MigrationStatus is an optional interface that a submodule can implement to report its running migration.
+type MigrationStatus interface {
+	IsMigrating() bool
+}
+
+// ReindexSource provides the blocks and the states to replay them. It is implemented by the app to enable reindexing.
+type ReindexSource interface {
+	// FinalizeBlock returns the FinalizeBlock request and response of the height.
+	FinalizeBlock(height int64) (abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock, error)
+	// QueryContext returns a read-only sdk context of the state at the height.
+	QueryContext(height int64) (context.Context, error)
+}
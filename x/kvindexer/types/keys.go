@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "indexer"
@@ -16,3 +18,7 @@ const (
 
 	// No Router Key for this module
 )
+
+// CommittedHeightPrefix is the prefix of the latest height committed by the indexer.
+// It doesn't collide with the submodules' prefixes, which start with their names.
+var CommittedHeightPrefix = collections.NewPrefix(ModuleName + "/committed_height")
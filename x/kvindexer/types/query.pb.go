@@ -192,38 +192,165 @@ func (m *QueryVMTypeResponse) GetVmtype() string {
 	return ""
 }
 
+// QueryHealthRequest is the request type for the Query/Health RPC method
+type QueryHealthRequest struct {
+}
+
+func (m *QueryHealthRequest) Reset()         { *m = QueryHealthRequest{} }
+func (m *QueryHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthRequest) ProtoMessage()    {}
+func (*QueryHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81019926f3a532d0, []int{4}
+}
+func (m *QueryHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthRequest.Merge(m, src)
+}
+func (m *QueryHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHealthRequest proto.InternalMessageInfo
+
+// QueryHealthResponse is the response type for the Query/Health RPC method
+type QueryHealthResponse struct {
+	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	// reasons describes why the indexer is not ready
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// chain_height is the latest committed height of the chain
+	ChainHeight int64 `protobuf:"varint,3,opt,name=chain_height,json=chainHeight,proto3" json:"chain_height,omitempty"`
+	// indexed_height is the latest height committed by the indexer
+	IndexedHeight int64              `protobuf:"varint,4,opt,name=indexed_height,json=indexedHeight,proto3" json:"indexed_height,omitempty"`
+	Submodules    []*SubmoduleHealth `protobuf:"bytes,5,rep,name=submodules,proto3" json:"submodules,omitempty"`
+}
+
+func (m *QueryHealthResponse) Reset()         { *m = QueryHealthResponse{} }
+func (m *QueryHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthResponse) ProtoMessage()    {}
+func (*QueryHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81019926f3a532d0, []int{5}
+}
+func (m *QueryHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthResponse.Merge(m, src)
+}
+func (m *QueryHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHealthResponse proto.InternalMessageInfo
+
+func (m *QueryHealthResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *QueryHealthResponse) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func (m *QueryHealthResponse) GetChainHeight() int64 {
+	if m != nil {
+		return m.ChainHeight
+	}
+	return 0
+}
+
+func (m *QueryHealthResponse) GetIndexedHeight() int64 {
+	if m != nil {
+		return m.IndexedHeight
+	}
+	return 0
+}
+
+func (m *QueryHealthResponse) GetSubmodules() []*SubmoduleHealth {
+	if m != nil {
+		return m.Submodules
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryVersionRequest)(nil), "indexer.info.QueryVersionRequest")
 	proto.RegisterType((*QueryVersionResponse)(nil), "indexer.info.QueryVersionResponse")
 	proto.RegisterType((*QueryVMTypeRequest)(nil), "indexer.info.QueryVMTypeRequest")
 	proto.RegisterType((*QueryVMTypeResponse)(nil), "indexer.info.QueryVMTypeResponse")
+	proto.RegisterType((*QueryHealthRequest)(nil), "indexer.info.QueryHealthRequest")
+	proto.RegisterType((*QueryHealthResponse)(nil), "indexer.info.QueryHealthResponse")
 }
 
 func init() { proto.RegisterFile("indexer/info/query.proto", fileDescriptor_81019926f3a532d0) }
 
 var fileDescriptor_81019926f3a532d0 = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x6b, 0x10, 0x55, 0x31, 0x48, 0x80, 0x29, 0x10, 0x45, 0xc8, 0x6a, 0x33, 0x75, 0x69,
-	0x2c, 0x95, 0x8d, 0x91, 0x19, 0x06, 0x0a, 0x62, 0x60, 0x4b, 0xa8, 0x5b, 0xac, 0x26, 0x76, 0x1a,
-	0x3b, 0x51, 0xb3, 0xf2, 0x04, 0x48, 0xbc, 0x14, 0x63, 0x25, 0x16, 0x06, 0x06, 0x94, 0xf0, 0x20,
-	0x28, 0x89, 0x03, 0x89, 0x54, 0x75, 0xb3, 0xef, 0x7e, 0xff, 0xdf, 0x7f, 0x3e, 0x68, 0x30, 0x3e,
-	0xa1, 0x4b, 0x1a, 0x12, 0xc6, 0xa7, 0x82, 0x2c, 0x22, 0x1a, 0x26, 0x76, 0x10, 0x0a, 0x25, 0xd0,
-	0xbe, 0xee, 0xd8, 0x79, 0xc7, 0x3c, 0x9f, 0x09, 0x31, 0xf3, 0x28, 0x71, 0x02, 0x46, 0x1c, 0xce,
-	0x85, 0x72, 0x14, 0x13, 0x5c, 0x96, 0x5a, 0xb3, 0xe9, 0xa2, 0x92, 0x80, 0xea, 0x8e, 0x75, 0x02,
-	0x8f, 0x6f, 0x73, 0xd3, 0x07, 0x1a, 0x4a, 0x26, 0xf8, 0x98, 0x2e, 0x22, 0x2a, 0x95, 0x35, 0x86,
-	0xdd, 0x66, 0x59, 0x06, 0x82, 0x4b, 0x8a, 0x2e, 0x61, 0x27, 0x2e, 0x4b, 0xd2, 0x00, 0xbd, 0xed,
-	0xc1, 0xde, 0x08, 0xdb, 0xf5, 0x1c, 0xf6, 0x5d, 0xe4, 0xfa, 0x62, 0x12, 0x79, 0xb4, 0x7a, 0xf9,
-	0xa7, 0xb7, 0xba, 0x10, 0x95, 0x9e, 0x37, 0xf7, 0x49, 0x40, 0x2b, 0xd2, 0xb0, 0x0a, 0xa0, 0xab,
-	0x1a, 0x74, 0x0a, 0xdb, 0xb1, 0x9f, 0x07, 0x35, 0x40, 0x0f, 0x0c, 0x76, 0xc7, 0xfa, 0x36, 0xfa,
-	0x02, 0x70, 0xa7, 0xd0, 0xa3, 0x39, 0xec, 0x68, 0x86, 0x44, 0xfd, 0x66, 0x88, 0x35, 0x13, 0x99,
-	0xd6, 0x26, 0x49, 0x09, 0xb5, 0x8c, 0x97, 0x8f, 0x9f, 0xb7, 0x2d, 0x84, 0x0e, 0x49, 0xf5, 0x5f,
-	0x3a, 0x3c, 0x9a, 0xc2, 0x76, 0x19, 0x10, 0xf5, 0xd6, 0xf9, 0xd4, 0x27, 0x32, 0xfb, 0x1b, 0x14,
-	0x1a, 0x74, 0x56, 0x80, 0x8e, 0xd0, 0xc1, 0x3f, 0xa8, 0x18, 0xef, 0xea, 0xfa, 0x3d, 0xc5, 0x60,
-	0x95, 0x62, 0xf0, 0x9d, 0x62, 0xf0, 0x9a, 0xe1, 0xd6, 0x2a, 0xc3, 0xad, 0xcf, 0x0c, 0xb7, 0x1e,
-	0x47, 0x33, 0xa6, 0x9e, 0x23, 0xd7, 0x7e, 0x12, 0x3e, 0x61, 0x9c, 0x29, 0xe6, 0x0c, 0x3d, 0xc7,
-	0x95, 0x64, 0x1e, 0x57, 0x16, 0xcb, 0xda, 0xb9, 0x58, 0xb1, 0xdb, 0x2e, 0x76, 0x7c, 0xf1, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x25, 0x57, 0xdf, 0x02, 0x45, 0x02, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x3d, 0x8f, 0xd3, 0x40,
+	0x10, 0xcd, 0x26, 0x24, 0xe4, 0xe6, 0x8e, 0xaf, 0x4d, 0x00, 0xcb, 0x02, 0xcb, 0xb1, 0x84, 0x94,
+	0xe6, 0x6c, 0x29, 0x74, 0x48, 0x34, 0x54, 0x57, 0x40, 0x81, 0x41, 0x14, 0x34, 0x68, 0x73, 0xd9,
+	0x8b, 0x57, 0x97, 0xec, 0xfa, 0xbc, 0xeb, 0xe8, 0xd2, 0xf2, 0x0b, 0x90, 0xf8, 0x4b, 0x14, 0x54,
+	0xe8, 0x24, 0x1a, 0x4a, 0x94, 0xf0, 0x43, 0x90, 0xf7, 0xe3, 0xce, 0x96, 0x72, 0xe9, 0xbc, 0x6f,
+	0xde, 0xbe, 0x37, 0x33, 0xcf, 0x0b, 0x1e, 0xe3, 0x33, 0x7a, 0x49, 0x8b, 0x84, 0xf1, 0x33, 0x91,
+	0x5c, 0x94, 0xb4, 0x58, 0xc7, 0x79, 0x21, 0x94, 0xc0, 0x47, 0xb6, 0x12, 0x57, 0x15, 0xff, 0xd9,
+	0x5c, 0x88, 0xf9, 0x82, 0x26, 0x24, 0x67, 0x09, 0xe1, 0x5c, 0x28, 0xa2, 0x98, 0xe0, 0xd2, 0x70,
+	0xfd, 0xa6, 0x8a, 0x5a, 0xe7, 0xd4, 0x56, 0xa2, 0xc7, 0x30, 0x78, 0x5f, 0x89, 0x7e, 0xa2, 0x85,
+	0x64, 0x82, 0xa7, 0xf4, 0xa2, 0xa4, 0x52, 0x45, 0x29, 0x0c, 0x9b, 0xb0, 0xcc, 0x05, 0x97, 0x14,
+	0xbf, 0x82, 0xfe, 0xca, 0x40, 0xd2, 0x43, 0x61, 0x67, 0x7c, 0x38, 0x09, 0xe2, 0x7a, 0x1f, 0xf1,
+	0x87, 0x72, 0xba, 0x14, 0xb3, 0x72, 0x41, 0xdd, 0xcd, 0x6b, 0x7e, 0x34, 0x04, 0x6c, 0x34, 0xdf,
+	0x7d, 0x5c, 0xe7, 0xd4, 0x39, 0x1d, 0xc3, 0xa0, 0x81, 0x5a, 0xa3, 0x27, 0xd0, 0x5b, 0x2d, 0xab,
+	0x46, 0x3d, 0x14, 0xa2, 0xf1, 0x41, 0x6a, 0x4f, 0xd7, 0x22, 0x27, 0x94, 0x2c, 0x54, 0xe6, 0x44,
+	0x7e, 0x21, 0x18, 0x34, 0x60, 0xab, 0x32, 0x84, 0x6e, 0x41, 0xc9, 0x6c, 0xad, 0x45, 0xfa, 0xa9,
+	0x39, 0x60, 0x0f, 0xee, 0x16, 0x94, 0xc8, 0x6a, 0x86, 0x76, 0xd8, 0x19, 0x1f, 0xa4, 0xee, 0x88,
+	0x47, 0x70, 0x74, 0x9a, 0x11, 0xc6, 0xbf, 0x64, 0x94, 0xcd, 0x33, 0xe5, 0x75, 0x42, 0x34, 0xee,
+	0xa4, 0x87, 0x1a, 0x3b, 0xd1, 0x10, 0x7e, 0x01, 0xf7, 0xcd, 0xc0, 0x33, 0x47, 0xba, 0xa3, 0x49,
+	0xf7, 0x2c, 0x6a, 0x69, 0xaf, 0x01, 0xa4, 0x5b, 0x85, 0xf4, 0xba, 0x7a, 0x55, 0xcf, 0x6f, 0x59,
+	0x95, 0x6d, 0xba, 0x76, 0x61, 0xf2, 0xa3, 0x0d, 0x5d, 0x3d, 0x10, 0x3e, 0x87, 0xbe, 0x5d, 0xa5,
+	0xc4, 0xa3, 0xa6, 0xc0, 0x8e, 0xe0, 0xfc, 0x68, 0x1f, 0xc5, 0x6c, 0x25, 0xf2, 0xbe, 0xfe, 0xfe,
+	0xf7, 0xbd, 0x8d, 0xf1, 0xc3, 0xc4, 0xfd, 0x16, 0x36, 0x23, 0x7c, 0x06, 0x3d, 0x93, 0x03, 0x0e,
+	0x77, 0xe9, 0xd4, 0x83, 0xf3, 0x47, 0x7b, 0x18, 0xd6, 0xe8, 0xa9, 0x36, 0x7a, 0x84, 0x1f, 0xdc,
+	0x18, 0xe9, 0x14, 0x2b, 0x1f, 0x33, 0xf4, 0x4e, 0x9f, 0x46, 0xb6, 0xfe, 0x68, 0x0f, 0xe3, 0x56,
+	0x9f, 0x4c, 0x13, 0xde, 0xbc, 0xfd, 0xb9, 0x09, 0xd0, 0xd5, 0x26, 0x40, 0x7f, 0x37, 0x01, 0xfa,
+	0xb6, 0x0d, 0x5a, 0x57, 0xdb, 0xa0, 0xf5, 0x67, 0x1b, 0xb4, 0x3e, 0x4f, 0xe6, 0x4c, 0x65, 0xe5,
+	0x34, 0x3e, 0x15, 0xcb, 0x84, 0x71, 0xa6, 0x18, 0x39, 0x5e, 0x90, 0xa9, 0x4c, 0xce, 0x57, 0x4e,
+	0xe2, 0xb2, 0xf6, 0xad, 0x5f, 0xcc, 0xb4, 0xa7, 0x9f, 0xcc, 0xcb, 0xff, 0x03, 0x00, 0xaf, 0x82,
+	0xb9, 0xfb, 0x94, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -242,6 +369,8 @@ type QueryClient interface {
 	Versions(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error)
 	// VMType queries the type of the Minitia's VM
 	VMType(ctx context.Context, in *QueryVMTypeRequest, opts ...grpc.CallOption) (*QueryVMTypeResponse, error)
+	// Health returns whether the indexer is caught up with the chain and healthy
+	Health(ctx context.Context, in *QueryHealthRequest, opts ...grpc.CallOption) (*QueryHealthResponse, error)
 }
 
 type queryClient struct {
@@ -270,12 +399,23 @@ func (c *queryClient) VMType(ctx context.Context, in *QueryVMTypeRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Health(ctx context.Context, in *QueryHealthRequest, opts ...grpc.CallOption) (*QueryHealthResponse, error) {
+	out := new(QueryHealthResponse)
+	err := c.cc.Invoke(ctx, "/indexer.info.Query/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Version queries all the versions of the submodules
 	Versions(context.Context, *QueryVersionRequest) (*QueryVersionResponse, error)
 	// VMType queries the type of the Minitia's VM
 	VMType(context.Context, *QueryVMTypeRequest) (*QueryVMTypeResponse, error)
+	// Health returns whether the indexer is caught up with the chain and healthy
+	Health(context.Context, *QueryHealthRequest) (*QueryHealthResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -288,6 +428,9 @@ func (*UnimplementedQueryServer) Versions(ctx context.Context, req *QueryVersion
 func (*UnimplementedQueryServer) VMType(ctx context.Context, req *QueryVMTypeRequest) (*QueryVMTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VMType not implemented")
 }
+func (*UnimplementedQueryServer) Health(ctx context.Context, req *QueryHealthRequest) (*QueryHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -329,6 +472,24 @@ func _Query_VMType_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/indexer.info.Query/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Health(ctx, req.(*QueryHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "indexer.info.Query",
@@ -342,6 +503,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VMType",
 			Handler:    _Query_VMType_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _Query_Health_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "indexer/info/query.proto",
@@ -460,6 +625,95 @@ func (m *QueryVMTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submodules) > 0 {
+		for iNdEx := len(m.Submodules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submodules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.IndexedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChainHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -517,6 +771,45 @@ func (m *QueryVMTypeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ready {
+		n += 2
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ChainHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ChainHeight))
+	}
+	if m.IndexedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.IndexedHeight))
+	}
+	if len(m.Submodules) > 0 {
+		for _, e := range m.Submodules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -789,6 +1082,230 @@ func (m *QueryVMTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHeight", wireType)
+			}
+			m.ChainHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedHeight", wireType)
+			}
+			m.IndexedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submodules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submodules = append(m.Submodules, &SubmoduleHealth{})
+			if err := m.Submodules[len(m.Submodules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -65,30 +65,306 @@ func (m *SubmoduleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SubmoduleVersion proto.InternalMessageInfo
 
+// InvariantViolation describes an index entry breaking an invariant
+type InvariantViolation struct {
+	// invariant is the name of the broken invariant
+	Invariant string `protobuf:"bytes,1,opt,name=invariant,proto3" json:"invariant,omitempty"`
+	// description describes the broken entry
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *InvariantViolation) Reset()         { *m = InvariantViolation{} }
+func (m *InvariantViolation) String() string { return proto.CompactTextString(m) }
+func (*InvariantViolation) ProtoMessage()    {}
+func (*InvariantViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07f8f35a2cd80b30, []int{1}
+}
+func (m *InvariantViolation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantViolation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantViolation.Merge(m, src)
+}
+func (m *InvariantViolation) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantViolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantViolation proto.InternalMessageInfo
+
+// SubmoduleVerification defines the verification result of the submodule
+type SubmoduleVerification struct {
+	Submodule string `protobuf:"bytes,1,opt,name=submodule,proto3" json:"submodule,omitempty"`
+	// verified is false if the submodule doesn't support verification
+	Verified   bool                 `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	Violations []InvariantViolation `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations"`
+}
+
+func (m *SubmoduleVerification) Reset()         { *m = SubmoduleVerification{} }
+func (m *SubmoduleVerification) String() string { return proto.CompactTextString(m) }
+func (*SubmoduleVerification) ProtoMessage()    {}
+func (*SubmoduleVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07f8f35a2cd80b30, []int{2}
+}
+func (m *SubmoduleVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmoduleVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmoduleVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmoduleVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmoduleVerification.Merge(m, src)
+}
+func (m *SubmoduleVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmoduleVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmoduleVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmoduleVerification proto.InternalMessageInfo
+
+// Correction describes an index entry fixed by the reconciliation
+type Correction struct {
+	// target is the key of the corrected entry
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// field is the name of the corrected field
+	Field    string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *Correction) Reset()         { *m = Correction{} }
+func (m *Correction) String() string { return proto.CompactTextString(m) }
+func (*Correction) ProtoMessage()    {}
+func (*Correction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07f8f35a2cd80b30, []int{3}
+}
+func (m *Correction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Correction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Correction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Correction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Correction.Merge(m, src)
+}
+func (m *Correction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Correction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Correction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Correction proto.InternalMessageInfo
+
+// SubmoduleReconciliation defines the reconciliation result of the submodule
+type SubmoduleReconciliation struct {
+	Submodule string `protobuf:"bytes,1,opt,name=submodule,proto3" json:"submodule,omitempty"`
+	// reconciled is false if the submodule doesn't support reconciliation
+	Reconciled  bool         `protobuf:"varint,2,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+	Corrections []Correction `protobuf:"bytes,3,rep,name=corrections,proto3" json:"corrections"`
+	// failures are the entries which couldn't be reconciled
+	Failures []ReconcileFailure `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures"`
+}
+
+func (m *SubmoduleReconciliation) Reset()         { *m = SubmoduleReconciliation{} }
+func (m *SubmoduleReconciliation) String() string { return proto.CompactTextString(m) }
+func (*SubmoduleReconciliation) ProtoMessage()    {}
+func (*SubmoduleReconciliation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07f8f35a2cd80b30, []int{4}
+}
+func (m *SubmoduleReconciliation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmoduleReconciliation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmoduleReconciliation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmoduleReconciliation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmoduleReconciliation.Merge(m, src)
+}
+func (m *SubmoduleReconciliation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmoduleReconciliation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmoduleReconciliation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmoduleReconciliation proto.InternalMessageInfo
+
+// ReconcileFailure describes an index entry the reconciliation failed to fix
+type ReconcileFailure struct {
+	// target is the key of the entry
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ReconcileFailure) Reset()         { *m = ReconcileFailure{} }
+func (m *ReconcileFailure) String() string { return proto.CompactTextString(m) }
+func (*ReconcileFailure) ProtoMessage()    {}
+func (*ReconcileFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07f8f35a2cd80b30, []int{5}
+}
+func (m *ReconcileFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconcileFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileFailure.Merge(m, src)
+}
+func (m *ReconcileFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileFailure proto.InternalMessageInfo
+
+// SubmoduleHealth defines the indexing status of the submodule
+type SubmoduleHealth struct {
+	Submodule string `protobuf:"bytes,1,opt,name=submodule,proto3" json:"submodule,omitempty"`
+	// indexed_height is the latest height successfully indexed by the submodule
+	IndexedHeight int64 `protobuf:"varint,2,opt,name=indexed_height,json=indexedHeight,proto3" json:"indexed_height,omitempty"`
+	// failed_height is the latest height the submodule failed to index
+	FailedHeight int64 `protobuf:"varint,3,opt,name=failed_height,json=failedHeight,proto3" json:"failed_height,omitempty"`
+	Migrating    bool  `protobuf:"varint,4,opt,name=migrating,proto3" json:"migrating,omitempty"`
+}
+
+func (m *SubmoduleHealth) Reset()         { *m = SubmoduleHealth{} }
+func (m *SubmoduleHealth) String() string { return proto.CompactTextString(m) }
+func (*SubmoduleHealth) ProtoMessage()    {}
+func (*SubmoduleHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07f8f35a2cd80b30, []int{6}
+}
+func (m *SubmoduleHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmoduleHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmoduleHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmoduleHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmoduleHealth.Merge(m, src)
+}
+func (m *SubmoduleHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmoduleHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmoduleHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmoduleHealth proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SubmoduleVersion)(nil), "indexer.info.SubmoduleVersion")
+	proto.RegisterType((*InvariantViolation)(nil), "indexer.info.InvariantViolation")
+	proto.RegisterType((*SubmoduleVerification)(nil), "indexer.info.SubmoduleVerification")
+	proto.RegisterType((*Correction)(nil), "indexer.info.Correction")
+	proto.RegisterType((*SubmoduleReconciliation)(nil), "indexer.info.SubmoduleReconciliation")
+	proto.RegisterType((*ReconcileFailure)(nil), "indexer.info.ReconcileFailure")
+	proto.RegisterType((*SubmoduleHealth)(nil), "indexer.info.SubmoduleHealth")
 }
 
 func init() { proto.RegisterFile("indexer/info/types.proto", fileDescriptor_07f8f35a2cd80b30) }
 
 var fileDescriptor_07f8f35a2cd80b30 = []byte{
-	// 251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x4e, 0xc3, 0x40,
-	0x10, 0x45, 0xbd, 0x14, 0xa0, 0x58, 0x14, 0x60, 0x51, 0x58, 0x11, 0x5a, 0x10, 0x15, 0x0d, 0x1e,
-	0x05, 0x6e, 0x40, 0x49, 0x09, 0x88, 0x82, 0x6e, 0xd7, 0x99, 0x98, 0x11, 0xde, 0x1d, 0xcb, 0xbb,
-	0xb6, 0xc2, 0x2d, 0x38, 0x06, 0x47, 0x49, 0x99, 0x92, 0x12, 0xec, 0x8b, 0xa0, 0xac, 0x6d, 0x91,
-	0x66, 0xf4, 0xff, 0x7f, 0xbf, 0x18, 0xfd, 0x38, 0x25, 0xbb, 0xc4, 0x35, 0xd6, 0x40, 0x76, 0xc5,
-	0xe0, 0x3f, 0x2a, 0x74, 0x59, 0x55, 0xb3, 0xe7, 0xe4, 0x78, 0x24, 0xd9, 0x8e, 0xcc, 0xcf, 0x0a,
-	0x2e, 0x38, 0x00, 0xd8, 0xa9, 0xa1, 0x33, 0x3f, 0x55, 0x86, 0x2c, 0x43, 0xb8, 0x63, 0x74, 0x51,
-	0x30, 0x17, 0x25, 0x42, 0x70, 0xba, 0x59, 0x81, 0x27, 0x83, 0xce, 0x2b, 0x53, 0x8d, 0x05, 0x99,
-	0xb3, 0x33, 0xec, 0x40, 0x2b, 0x87, 0xd0, 0x2e, 0x34, 0x7a, 0xb5, 0x80, 0x9c, 0xc9, 0x0e, 0xfc,
-	0xea, 0x21, 0x3e, 0x79, 0x6a, 0xb4, 0xe1, 0x65, 0x53, 0xe2, 0x0b, 0xd6, 0x8e, 0xd8, 0x26, 0xe7,
-	0xf1, 0xcc, 0x4d, 0x59, 0x2a, 0x2e, 0xc5, 0xf5, 0xec, 0xf1, 0x3f, 0x48, 0xd2, 0xf8, 0xa8, 0x1d,
-	0x8a, 0xe9, 0x41, 0x60, 0x93, 0xbd, 0x7f, 0xde, 0xfc, 0xca, 0xe8, 0xab, 0x93, 0x62, 0xd3, 0x49,
-	0xb1, 0xed, 0xa4, 0xf8, 0xe9, 0xa4, 0xf8, 0xec, 0x65, 0xb4, 0xed, 0x65, 0xf4, 0xdd, 0xcb, 0xe8,
-	0xf5, 0xb6, 0x20, 0xff, 0xd6, 0xe8, 0x2c, 0x67, 0x03, 0x64, 0xc9, 0x93, 0xba, 0x29, 0x95, 0x76,
-	0xf0, 0xde, 0x4e, 0xc3, 0xac, 0xf7, 0x74, 0xd8, 0x47, 0x1f, 0x86, 0x47, 0xef, 0xfe, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x9f, 0xcb, 0x8f, 0x4b, 0x3c, 0x01, 0x00, 0x00,
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x49, 0x29, 0xc9, 0xb4, 0x85, 0x62, 0x15, 0xb0, 0x4a, 0xe5, 0x46, 0x46, 0x48, 0xbd,
+	0x10, 0xab, 0xe5, 0x07, 0xaa, 0x22, 0x55, 0x85, 0xa3, 0xa9, 0x7a, 0xe0, 0x52, 0xad, 0xed, 0x89,
+	0x33, 0xc2, 0xde, 0x8d, 0xd6, 0x6b, 0xb7, 0xfc, 0x05, 0x57, 0xfe, 0x80, 0x4f, 0xc9, 0xb1, 0x47,
+	0x24, 0x24, 0x04, 0xc9, 0x8f, 0x20, 0xaf, 0xd7, 0x8e, 0x0b, 0x52, 0xe1, 0x12, 0xed, 0xbc, 0xf7,
+	0x66, 0xf4, 0xe6, 0x6d, 0xbc, 0xe0, 0x10, 0x8f, 0xf1, 0x1a, 0xa5, 0x4f, 0x7c, 0x22, 0x7c, 0xf5,
+	0x69, 0x86, 0xf9, 0x78, 0x26, 0x85, 0x12, 0xf6, 0xa6, 0x61, 0xc6, 0x15, 0xb3, 0xbb, 0x93, 0x88,
+	0x44, 0x68, 0xc2, 0xaf, 0x4e, 0xb5, 0x66, 0xf7, 0x31, 0xcb, 0x88, 0x0b, 0x5f, 0xff, 0x1a, 0x68,
+	0x3f, 0x11, 0x22, 0x49, 0xd1, 0xd7, 0x55, 0x58, 0x4c, 0x7c, 0x45, 0x19, 0xe6, 0x8a, 0x65, 0x33,
+	0x23, 0x70, 0x23, 0x91, 0x67, 0x22, 0xf7, 0x43, 0x96, 0xa3, 0x5f, 0x1e, 0x86, 0xa8, 0xd8, 0xa1,
+	0x1f, 0x09, 0xe2, 0x35, 0xef, 0xbd, 0x83, 0xed, 0xf7, 0x45, 0x98, 0x89, 0xb8, 0x48, 0xf1, 0x02,
+	0x65, 0x4e, 0x82, 0xdb, 0x7b, 0x30, 0xcc, 0x1b, 0xcc, 0xb1, 0x46, 0xd6, 0xc1, 0x30, 0x58, 0x01,
+	0xb6, 0x03, 0x0f, 0xca, 0x5a, 0xe8, 0xdc, 0xd3, 0x5c, 0x53, 0x7a, 0xe7, 0x60, 0xbf, 0xe5, 0x25,
+	0x93, 0xc4, 0xb8, 0xba, 0x20, 0x91, 0x32, 0x65, 0xa6, 0x51, 0x83, 0x36, 0xd3, 0x5a, 0xc0, 0x1e,
+	0xc1, 0x46, 0x8c, 0x79, 0x24, 0x69, 0xa6, 0x56, 0x13, 0xbb, 0x90, 0xf7, 0xc5, 0x82, 0x27, 0x5d,
+	0x8b, 0x34, 0xa1, 0xa8, 0x9d, 0x7c, 0x87, 0xcf, 0x5d, 0x18, 0x94, 0x5a, 0x8d, 0xb1, 0x1e, 0x3b,
+	0x08, 0xda, 0xda, 0x3e, 0x05, 0x28, 0x1b, 0x83, 0xb9, 0xd3, 0x1f, 0xf5, 0x0f, 0x36, 0x8e, 0x46,
+	0xe3, 0xee, 0x15, 0x8c, 0xff, 0xde, 0xe4, 0x64, 0x6d, 0xfe, 0x63, 0xbf, 0x17, 0x74, 0x3a, 0x3d,
+	0x05, 0xf0, 0x46, 0x48, 0x89, 0x91, 0xf6, 0xf3, 0x14, 0xd6, 0x15, 0x93, 0x09, 0x36, 0x6b, 0x9a,
+	0xca, 0xde, 0x81, 0xfb, 0x13, 0xc2, 0x34, 0x36, 0xdb, 0xd5, 0x85, 0xfd, 0x1c, 0x86, 0x22, 0x8d,
+	0x2f, 0x4b, 0x96, 0x16, 0xe8, 0xf4, 0x35, 0x33, 0x10, 0x69, 0x7c, 0x51, 0xd5, 0x15, 0xc9, 0xf1,
+	0xca, 0x90, 0x6b, 0x35, 0xc9, 0xf1, 0x4a, 0x93, 0xde, 0x77, 0x0b, 0x9e, 0xb5, 0x89, 0x04, 0x18,
+	0x09, 0x1e, 0x51, 0x4a, 0xff, 0x93, 0x89, 0x0b, 0x20, 0x8d, 0xbe, 0x4d, 0xa5, 0x83, 0xd8, 0xc7,
+	0xb0, 0x11, 0xb5, 0xfb, 0x34, 0xc1, 0x38, 0xb7, 0x83, 0x59, 0x2d, 0x6c, 0x02, 0xe9, 0xb6, 0xd8,
+	0xc7, 0x30, 0x98, 0x30, 0x4a, 0x0b, 0x89, 0xb9, 0xb3, 0xa6, 0xdb, 0xdd, 0xdb, 0xed, 0x8d, 0x5f,
+	0x3c, 0xad, 0x65, 0x66, 0x48, 0xdb, 0xe5, 0x1d, 0xc3, 0xf6, 0x9f, 0x9a, 0xbb, 0x92, 0x45, 0x29,
+	0x85, 0x6c, 0x92, 0xd5, 0x45, 0xf5, 0x8f, 0x79, 0xd4, 0xe6, 0x73, 0x86, 0x2c, 0x55, 0xd3, 0x7f,
+	0xe4, 0xf2, 0x12, 0x1e, 0xd6, 0x26, 0xe3, 0xcb, 0x29, 0x52, 0x32, 0x55, 0x7a, 0x60, 0x3f, 0xd8,
+	0x32, 0xe8, 0x99, 0x06, 0xed, 0x17, 0xb0, 0x55, 0xd9, 0x5c, 0xa9, 0xfa, 0x5a, 0xb5, 0x59, 0x83,
+	0x46, 0xb4, 0x07, 0xc3, 0x8c, 0x12, 0xc9, 0x14, 0xf1, 0x44, 0x5f, 0xdd, 0x20, 0x58, 0x01, 0x27,
+	0xe7, 0xf3, 0x5f, 0x6e, 0xef, 0xeb, 0xc2, 0xb5, 0xe6, 0x0b, 0xd7, 0xba, 0x59, 0xb8, 0xd6, 0xcf,
+	0x85, 0x6b, 0x7d, 0x5e, 0xba, 0xbd, 0x9b, 0xa5, 0xdb, 0xfb, 0xb6, 0x74, 0x7b, 0x1f, 0x8e, 0x12,
+	0x52, 0xd3, 0x22, 0x1c, 0x47, 0x22, 0xf3, 0x89, 0x93, 0x22, 0xf6, 0x2a, 0x65, 0x61, 0xee, 0x7f,
+	0x2c, 0x9b, 0xc7, 0xe3, 0xba, 0x73, 0xd6, 0x6f, 0x48, 0xb8, 0xae, 0x3f, 0xe6, 0xd7, 0xbf, 0x07,
+	0x00, 0x1d, 0x2f, 0x53, 0x22, 0x60, 0x04, 0x00, 0x00,
 }
 
 func (this *SubmoduleVersion) Equal(that interface{}) bool {
@@ -105,91 +381,1429 @@ func (this *SubmoduleVersion) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Submodule != that1.Submodule {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	return true
-}
-func (m *SubmoduleVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SubmoduleVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubmoduleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Submodule) > 0 {
-		i -= len(m.Submodule)
-		copy(dAtA[i:], m.Submodule)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submodule)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Submodule != that1.Submodule {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *InvariantViolation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InvariantViolation)
+	if !ok {
+		that2, ok := that.(InvariantViolation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Invariant != that1.Invariant {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
+}
+func (this *SubmoduleVerification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubmoduleVerification)
+	if !ok {
+		that2, ok := that.(SubmoduleVerification)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Submodule != that1.Submodule {
+		return false
+	}
+	if this.Verified != that1.Verified {
+		return false
+	}
+	if len(this.Violations) != len(that1.Violations) {
+		return false
+	}
+	for i := range this.Violations {
+		if !this.Violations[i].Equal(&that1.Violations[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Correction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Correction)
+	if !ok {
+		that2, ok := that.(Correction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Target != that1.Target {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.OldValue != that1.OldValue {
+		return false
+	}
+	if this.NewValue != that1.NewValue {
+		return false
+	}
+	return true
+}
+func (this *SubmoduleReconciliation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubmoduleReconciliation)
+	if !ok {
+		that2, ok := that.(SubmoduleReconciliation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Submodule != that1.Submodule {
+		return false
+	}
+	if this.Reconciled != that1.Reconciled {
+		return false
+	}
+	if len(this.Corrections) != len(that1.Corrections) {
+		return false
+	}
+	for i := range this.Corrections {
+		if !this.Corrections[i].Equal(&that1.Corrections[i]) {
+			return false
+		}
+	}
+	if len(this.Failures) != len(that1.Failures) {
+		return false
+	}
+	for i := range this.Failures {
+		if !this.Failures[i].Equal(&that1.Failures[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ReconcileFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReconcileFailure)
+	if !ok {
+		that2, ok := that.(ReconcileFailure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Target != that1.Target {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *SubmoduleHealth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubmoduleHealth)
+	if !ok {
+		that2, ok := that.(SubmoduleHealth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Submodule != that1.Submodule {
+		return false
+	}
+	if this.IndexedHeight != that1.IndexedHeight {
+		return false
+	}
+	if this.FailedHeight != that1.FailedHeight {
+		return false
+	}
+	if this.Migrating != that1.Migrating {
+		return false
+	}
+	return true
+}
+func (m *SubmoduleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmoduleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmoduleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submodule) > 0 {
+		i -= len(m.Submodule)
+		copy(dAtA[i:], m.Submodule)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submodule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantViolation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantViolation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantViolation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Invariant) > 0 {
+		i -= len(m.Invariant)
+		copy(dAtA[i:], m.Invariant)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Invariant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmoduleVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmoduleVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmoduleVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Violations) > 0 {
+		for iNdEx := len(m.Violations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Violations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submodule) > 0 {
+		i -= len(m.Submodule)
+		copy(dAtA[i:], m.Submodule)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submodule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Correction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Correction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Correction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmoduleReconciliation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmoduleReconciliation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmoduleReconciliation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Corrections) > 0 {
+		for iNdEx := len(m.Corrections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corrections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Reconciled {
+		i--
+		if m.Reconciled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submodule) > 0 {
+		i -= len(m.Submodule)
+		copy(dAtA[i:], m.Submodule)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submodule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmoduleHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmoduleHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmoduleHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Migrating {
+		i--
+		if m.Migrating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FailedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IndexedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submodule) > 0 {
+		i -= len(m.Submodule)
+		copy(dAtA[i:], m.Submodule)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submodule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubmoduleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submodule)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *InvariantViolation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Invariant)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmoduleVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submodule)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	if len(m.Violations) > 0 {
+		for _, e := range m.Violations {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Correction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmoduleReconciliation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submodule)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Reconciled {
+		n += 2
+	}
+	if len(m.Corrections) > 0 {
+		for _, e := range m.Corrections {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReconcileFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmoduleHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submodule)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.IndexedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.IndexedHeight))
+	}
+	if m.FailedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.FailedHeight))
+	}
+	if m.Migrating {
+		n += 2
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubmoduleVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmoduleVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmoduleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submodule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submodule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantViolation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantViolation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantViolation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invariant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmoduleVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmoduleVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmoduleVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submodule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submodule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Violations = append(m.Violations, InvariantViolation{})
+			if err := m.Violations[len(m.Violations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Correction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Correction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Correction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmoduleReconciliation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmoduleReconciliation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmoduleReconciliation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submodule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submodule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reconciled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corrections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corrections = append(m.Corrections, Correction{})
+			if err := m.Corrections[len(m.Corrections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, ReconcileFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *SubmoduleVersion) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Submodule)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SubmoduleVersion) Unmarshal(dAtA []byte) error {
+func (m *ReconcileFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -212,15 +1826,15 @@ func (m *SubmoduleVersion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmoduleVersion: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReconcileFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmoduleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReconcileFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submodule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -248,11 +1862,11 @@ func (m *SubmoduleVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submodule = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -280,8 +1894,148 @@ func (m *SubmoduleVersion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmoduleHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmoduleHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmoduleHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submodule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submodule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedHeight", wireType)
+			}
+			m.IndexedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedHeight", wireType)
+			}
+			m.FailedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Migrating = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])